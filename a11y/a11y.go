@@ -0,0 +1,269 @@
+// Package a11y checks Node trees for common accessibility issues, e.g.,
+//
+//	for _, issue := range a11y.Check(app.View(&s)) {
+//		log.Print(issue)
+//	}
+//
+// The checks are static: they see the tree, not the page, so an issue
+// depending on styles from outside the tree, or on the layout, is missed.
+// In tests, see browsertest.Accessible.
+package a11y
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nlandolfi/browser"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// A Rule is a kind of issue.
+type Rule string
+
+const (
+	// ClickableNotInteractive is a non-interactive element (e.g., a div)
+	// with a click handler, but without a role or a tabindex, so that it
+	// can't be reached or used with a keyboard or screen reader.
+	ClickableNotInteractive Rule = "clickable-not-interactive"
+
+	// InputWithoutLabel is an input, select or textarea without a label:
+	// a label element for it, or around it, or an aria-label,
+	// aria-labelledby or title attribute.
+	InputWithoutLabel Rule = "input-without-label"
+
+	// ImageWithoutAlt is an img without an alt attribute; decorative
+	// images have an empty one.
+	ImageWithoutAlt Rule = "image-without-alt"
+
+	// LowContrast is text with a contrast ratio, between its color and
+	// background, below MinContrast.
+	LowContrast Rule = "low-contrast"
+
+	// DuplicateID is an id used by an earlier element.
+	DuplicateID Rule = "duplicate-id"
+)
+
+// MinContrast is the least contrast ratio of text to its background, as
+// WCAG 2 level AA requires of normal text.
+const MinContrast = 4.5
+
+// An Issue is a failure of a rule by a node.
+type Issue struct {
+	Rule    Rule
+	Node    *browser.Node
+	Path    string // of the node from the root, e.g., "div/div.form/input#name"
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Path, i.Rule, i.Message)
+}
+
+// Check returns the issues of the tree rooted at n, in document order.
+func Check(n *browser.Node) []Issue {
+	c := &checker{
+		ids:     make(map[string]bool),
+		labeled: make(map[string]bool),
+		colored: make(map[*browser.Node]bool),
+	}
+
+	// the ids labels are for, wherever the labels are
+	n.Walk(func(n *browser.Node) bool {
+		if n.DataAtom == atom.Label {
+			if id, ok := n.GetAttr("for"); ok {
+				c.labeled[id] = true
+			}
+		}
+		return true
+	})
+
+	c.check(n)
+	return c.issues
+}
+
+type checker struct {
+	issues  []Issue
+	path    []*browser.Node // the ancestors of the node checked
+	ids     map[string]bool
+	labeled map[string]bool
+	colored map[*browser.Node]bool // checked for contrast
+}
+
+func (c *checker) report(n *browser.Node, r Rule, format string, args ...interface{}) {
+	ns := c.path
+	if len(ns) == 0 || ns[len(ns)-1] != n {
+		ns = append(ns[:len(ns):len(ns)], n)
+	}
+	c.issues = append(c.issues, Issue{
+		Rule:    r,
+		Node:    n,
+		Path:    path(ns),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) check(n *browser.Node) {
+	switch n.Type {
+	case html.TextNode:
+		if strings.TrimSpace(n.Data) != "" && len(c.path) > 0 {
+			c.contrast(c.path[len(c.path)-1])
+		}
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	if id, ok := n.GetAttr("id"); ok && id != "" {
+		if c.ids[id] {
+			c.report(n, DuplicateID, "id %q is used by an earlier element", id)
+		}
+		c.ids[id] = true
+	}
+	c.clickable(n)
+	c.input(n)
+	if n.DataAtom == atom.Img {
+		if _, ok := n.GetAttr("alt"); !ok && !presentational(n) {
+			c.report(n, ImageWithoutAlt, "img has no alt attribute")
+		}
+	}
+
+	c.path = append(c.path, n)
+	for _, child := range n.Children {
+		c.check(child)
+	}
+	c.path = c.path[:len(c.path)-1]
+}
+
+func (c *checker) clickable(n *browser.Node) {
+	if n.Handlers.Click == nil || interactive(n) {
+		return
+	}
+
+	var missing []string
+	if _, ok := n.GetAttr("role"); !ok {
+		missing = append(missing, "role")
+	}
+	if _, ok := n.GetAttr("tabindex"); !ok {
+		missing = append(missing, "tabindex")
+	}
+	if len(missing) > 0 {
+		c.report(n, ClickableNotInteractive, "%s has a click handler but no %s", tag(n), strings.Join(missing, " or "))
+	}
+}
+
+func (c *checker) input(n *browser.Node) {
+	switch n.DataAtom {
+	case atom.Input:
+		t, _ := n.GetAttr("type")
+		switch strings.ToLower(t) {
+		case "hidden", "submit", "reset", "button", "image":
+			return // labeled by their value, or alt
+		}
+	case atom.Select, atom.Textarea:
+	default:
+		return
+	}
+
+	for _, a := range []string{"aria-label", "aria-labelledby", "title"} {
+		if v, ok := n.GetAttr(a); ok && strings.TrimSpace(v) != "" {
+			return
+		}
+	}
+	if id, ok := n.GetAttr("id"); ok && c.labeled[id] {
+		return
+	}
+	for _, p := range c.path {
+		if p.DataAtom == atom.Label {
+			return
+		}
+	}
+	c.report(n, InputWithoutLabel, "%s has no label", tag(n))
+}
+
+// contrast checks the text of n, if its color and background are known.
+func (c *checker) contrast(n *browser.Node) {
+	if c.colored[n] {
+		return
+	}
+	c.colored[n] = true
+
+	var fg, bg *browser.Color
+	for i := len(c.path) - 1; i >= 0 && (fg == nil || bg == nil); i-- {
+		s := &c.path[i].Style
+		if fg == nil && s.Color != "" {
+			fg = color(s.Color)
+			if fg == nil {
+				return // unknown, e.g., a var()
+			}
+		}
+		if bg == nil && (s.BackgroundColor != "" || s.Background != "") {
+			v := s.BackgroundColor
+			if v == "" {
+				v = s.Background
+			}
+			if bg = color(v); bg == nil {
+				return // unknown, or an image
+			}
+		}
+	}
+	if fg == nil || bg == nil || fg.A < 1 || bg.A < 1 {
+		return
+	}
+
+	if r := fg.Contrast(*bg); r < MinContrast {
+		c.report(n, LowContrast, "contrast of %s on %s is %.2f, want at least %.1f", fg, bg, r, MinContrast)
+	}
+}
+
+func color(v string) *browser.Color {
+	c, err := browser.ParseColor(v)
+	if err != nil {
+		return nil
+	}
+	return &c
+}
+
+// interactive reports whether n is focusable and usable with a keyboard,
+// without a role or tabindex.
+func interactive(n *browser.Node) bool {
+	switch n.DataAtom {
+	case atom.Button, atom.Input, atom.Select, atom.Textarea, atom.Summary, atom.Option, atom.Label:
+		return true
+	case atom.A, atom.Area:
+		_, ok := n.GetAttr("href")
+		return ok
+	}
+	return false
+}
+
+func presentational(n *browser.Node) bool {
+	role, _ := n.GetAttr("role")
+	return role == "presentation" || role == "none"
+}
+
+func tag(n *browser.Node) string {
+	if n.Data != "" {
+		return n.Data
+	}
+	return n.DataAtom.String()
+}
+
+// path describes the nodes by their tag, id and classes.
+func path(ns []*browser.Node) string {
+	parts := make([]string, len(ns))
+	for i, n := range ns {
+		p := tag(n)
+		if id, ok := n.GetAttr("id"); ok && id != "" {
+			p += "#" + id
+		}
+		if class, ok := n.GetAttr("class"); ok {
+			for _, c := range strings.Fields(class) {
+				p += "." + c
+			}
+		}
+		parts[i] = p
+	}
+	return strings.Join(parts, "/")
+}
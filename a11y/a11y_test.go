@@ -0,0 +1,48 @@
+package a11y
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+)
+
+func TestCheck(t *testing.T) {
+	view := browser.MustParseHTML(`<div style="background-color:white">` +
+		`<div id="save" class="button" style="color:#aaa">Save</div>` +
+		`<div id="ok" role="button" tabindex="0">OK</div>` +
+		`<a href="/">Home</a>` +
+		`<input id="name"><label for="name">Name</label>` +
+		`<input id="email" placeholder="Email">` +
+		`<label>Age <input type="number"></label>` +
+		`<textarea aria-label="Notes"></textarea>` +
+		`<input type="hidden" value="x">` +
+		`<img src="a.png"><img src="b.png" alt="">` +
+		`<p style="color:white;background:black">Dark<b id="ok">and bold</b></p>` +
+		`<p style="color:var(--text)">Themed</p>` +
+		`</div>`)[0]
+	click := func(dom.Event) {}
+	view.Find("#save").OnClick(click)
+	view.Find("#ok").OnClick(click)
+	view.Find("a").OnClick(click)
+
+	want := []struct {
+		rule Rule
+		path string
+	}{
+		{ClickableNotInteractive, "div/div#save.button"},
+		{LowContrast, "div/div#save.button"},
+		{InputWithoutLabel, "div/input#email"},
+		{ImageWithoutAlt, "div/img"},
+		{DuplicateID, "div/p/b#ok"},
+	}
+	got := Check(view)
+	if len(got) != len(want) {
+		t.Fatalf("got %d issues, want %d: %v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Rule != w.rule || got[i].Path != w.path {
+			t.Errorf("issue %d: got %s, want %s at %s", i, got[i], w.rule, w.path)
+		}
+	}
+}
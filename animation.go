@@ -0,0 +1,316 @@
+package browser
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transitions and Animations {{{
+
+// An Easing is a timing function, e.g., EaseInOut or CubicBezier(0.4, 0, 0.2, 1).
+type Easing string
+
+const (
+	EaseLinear Easing = "linear"
+	Ease       Easing = "ease"
+	EaseIn     Easing = "ease-in"
+	EaseOut    Easing = "ease-out"
+	EaseInOut  Easing = "ease-in-out"
+)
+
+func CubicBezier(x1, y1, x2, y2 float64) Easing {
+	return Easing("cubic-bezier(" + numbers(x1, y1, x2, y2) + ")")
+}
+
+func Steps(n int) Easing { return Easing(fmt.Sprintf("steps(%d)", n)) }
+
+// A Transition animates changes to a property, e.g.,
+//
+//	n.Transition(browser.Transitions(
+//		browser.Transition{Property: "opacity", Duration: 200 * time.Millisecond},
+//		browser.Transition{Property: "transform", Duration: 300 * time.Millisecond, Easing: browser.EaseOut},
+//	))
+//
+// An empty Property transitions all properties.
+type Transition struct {
+	Property string
+	Duration time.Duration
+	Easing   Easing
+	Delay    time.Duration
+}
+
+func (t Transition) String() string {
+	p := t.Property
+	if p == "" {
+		p = "all"
+	}
+	return join(p, duration(t.Duration), string(t.Easing), delay(t.Delay))
+}
+
+// A TransitionList is the value of the transition property.
+type TransitionList string
+
+// Transitions returns the transition list of the transitions.
+func Transitions(ts ...Transition) TransitionList {
+	ss := make([]string, len(ts))
+	for i, t := range ts {
+		ss[i] = t.String()
+	}
+	return TransitionList(strings.Join(ss, ", "))
+}
+
+// RawTransition sets the transition property to s, e.g., a transition
+// kept as a string; see Transitions for the typed builder.
+func (n *Node) RawTransition(s string) *Node { return n.Transition(TransitionList(s)) }
+
+// Keyframes are the styles of an animation, at offsets through it. The
+// Mounter writes the @keyframes rule of each animation in use to the
+// stylesheet it manages (see stylesheet.go), named by a hash of the rule.
+//
+// The keyframes of an animation parsed by ParseStyle have only a name, of
+// keyframes defined elsewhere, e.g., in the page's stylesheet, and the
+// Mounter writes no rule for them.
+type Keyframes struct {
+	name, rule string
+}
+
+// A Keyframe is the style at Offset, from 0 to 1, through an animation.
+type Keyframe struct {
+	Offset float64
+	Style  Style
+}
+
+// NewKeyframes returns the keyframes, e.g.,
+//
+//	var fadeIn = browser.NewKeyframes(
+//		browser.Keyframe{Offset: 0, Style: browser.Style{Opacity: "0"}},
+//		browser.Keyframe{Offset: 1, Style: browser.Style{Opacity: "1"}},
+//	)
+//
+// The variants and breakpoints of the styles are ignored.
+func NewKeyframes(frames ...Keyframe) Keyframes {
+	fs := make([]Keyframe, len(frames))
+	copy(fs, frames)
+	sort.SliceStable(fs, func(i, j int) bool { return fs[i].Offset < fs[j].Offset })
+
+	var body strings.Builder
+	for _, f := range fs {
+		if f.Offset < 0 || f.Offset > 1 {
+			panic(fmt.Sprintf("browser.NewKeyframes: offset %v out of [0, 1]", f.Offset))
+		}
+		body.WriteString(formatNumber(f.Offset*100) + "%{" + declarations(f.Style.Val(), "") + "}")
+	}
+
+	return keyframesOf(body.String())
+}
+
+// keyframesOf returns the keyframes with the body, named by its hash.
+func keyframesOf(body string) Keyframes {
+	h := fnv.New64a()
+	io.WriteString(h, body)
+	name := fmt.Sprintf("%s%x", classPrefix, h.Sum64())
+	return Keyframes{name: name, rule: "@keyframes " + name + "{" + body + "}"}
+}
+
+// frames returns the offsets, e.g., "50%", and the declarations of the
+// frames of k, in order.
+func (k Keyframes) frames() (offsets, declarations []string) {
+	body := strings.TrimSuffix(strings.TrimPrefix(k.rule, "@keyframes "+k.name+"{"), "}")
+	for body != "" {
+		i := strings.IndexByte(body, '{')
+		if i < 0 {
+			break
+		}
+		offsets = append(offsets, body[:i])
+
+		var sc scanner
+		j := i + 1
+		for j < len(body) && !(sc.next(body[j]) && body[j] == '}') {
+			j++
+		}
+		declarations = append(declarations, body[i+1:j])
+		body = body[min(j+1, len(body)):]
+	}
+	return offsets, declarations
+}
+
+// Name is the name of the animation, as referenced by the animation property.
+func (k Keyframes) Name() string { return k.name }
+
+// Infinite is the Iterations of an animation which repeats forever.
+var Infinite = math.Inf(1)
+
+// An Animation runs Keyframes on an element, e.g.,
+//
+//	n.Animation(browser.Animation{Keyframes: fadeIn, Duration: time.Second})
+//
+// A zero Iterations runs once.
+type Animation struct {
+	Keyframes  Keyframes
+	Duration   time.Duration
+	Easing     Easing
+	Delay      time.Duration
+	Iterations float64
+	Direction  AnimationDirectionType
+	FillMode   AnimationFillModeType
+}
+
+func (a *Animation) IsZero() bool { return *a == Animation{} }
+
+func (a *Animation) String() string {
+	var iterations string
+	switch {
+	case math.IsInf(a.Iterations, 1):
+		iterations = "infinite"
+	case a.Iterations != 0:
+		iterations = formatNumber(a.Iterations)
+	}
+	return join(a.Keyframes.name, duration(a.Duration), string(a.Easing), delay(a.Delay),
+		iterations, a.Direction.String(), a.FillMode.String())
+}
+
+// parseAnimation parses an animation, the keyframes of which have only
+// their name.
+func parseAnimation(v string) (a Animation, ok bool) {
+	times := 0
+	for _, f := range fields(v) {
+		lf := strings.ToLower(f)
+		if d, ok := parseTime(lf); ok {
+			if times == 0 {
+				a.Duration = d
+			} else if times == 1 {
+				a.Delay = d
+			} else {
+				return Animation{}, false
+			}
+			times++
+			continue
+		}
+		if e, ok := parseEasing(lf); ok && a.Easing == "" {
+			a.Easing = e
+			continue
+		}
+		if d, ok := parseAnimationDirectionType(lf); ok && a.Direction == AnimationDirectionUnset {
+			a.Direction = d
+			continue
+		}
+		if m, ok := parseAnimationFillModeType(lf); ok && a.FillMode == AnimationFillModeUnset {
+			a.FillMode = m
+			continue
+		}
+		if a.Iterations == 0 {
+			if lf == "infinite" {
+				a.Iterations = Infinite
+				continue
+			}
+			if n, err := strconv.ParseFloat(lf, 64); err == nil && n > 0 && numberPrefix(lf) == len(lf) {
+				a.Iterations = n
+				continue
+			}
+		}
+
+		if !ident(f) || lf == "none" || a.Keyframes.name != "" {
+			return Animation{}, false
+		}
+		a.Keyframes = Keyframes{name: f}
+	}
+
+	return a, a.Keyframes.name != ""
+}
+
+// ident reports whether s is a CSS identifier, e.g., "fade-in", but not
+// "1x" or "-2x". Escapes aren't recognized.
+func ident(s string) bool {
+	start := func(c byte) bool {
+		return c == '_' || c >= 0x80 || 'a' <= c|0x20 && c|0x20 <= 'z'
+	}
+	rest := strings.TrimPrefix(s, "-")
+	if rest == "" || !(start(rest[0]) || rest[0] == '-') {
+		return false
+	}
+	for i := 0; i < len(rest); i++ {
+		if c := rest[i]; !start(c) && c != '-' && !('0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTime parses a CSS time, e.g., "0.2s" or "200ms".
+func parseTime(v string) (time.Duration, bool) {
+	unit := time.Second
+	switch {
+	case strings.HasSuffix(v, "ms"):
+		v, unit = strings.TrimSuffix(v, "ms"), time.Millisecond
+	case strings.HasSuffix(v, "s"):
+		v = strings.TrimSuffix(v, "s")
+	default:
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || numberPrefix(v) != len(v) || math.Abs(f*float64(unit)) > math.MaxInt64 {
+		return 0, false
+	}
+	return time.Duration(math.Round(f * float64(unit))), true
+}
+
+func parseEasing(v string) (Easing, bool) {
+	switch e := Easing(v); e {
+	case EaseLinear, Ease, EaseIn, EaseOut, EaseInOut:
+		return e, true
+	}
+	if (strings.HasPrefix(v, "cubic-bezier(") || strings.HasPrefix(v, "steps(")) && strings.HasSuffix(v, ")") {
+		return Easing(v), true
+	}
+	return "", false
+}
+
+// keyframes returns the keyframes of the animations of s, its variants
+// and its breakpoints, which have rules.
+func (s *Style) keyframes() (ks []Keyframes) {
+	if s.Animation.Keyframes.rule != "" {
+		ks = append(ks, s.Animation.Keyframes)
+	}
+	for _, v := range s.variants() {
+		ks = append(ks, v.style.keyframes()...)
+	}
+	for i := range s.Breakpoints {
+		ks = append(ks, s.Breakpoints[i].Style.keyframes()...)
+	}
+	return ks
+}
+
+// duration formats d in seconds, as the first time of a transition or
+// animation, which is its duration.
+func duration(d time.Duration) string { return formatNumber(d.Seconds()) + "s" }
+
+// delay formats d in seconds, or "" if it is zero.
+func delay(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return duration(d)
+}
+
+// join joins the non-empty parts with spaces.
+func join(parts ...string) string {
+	var b strings.Builder
+	for _, p := range parts {
+		if p == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// }}}
@@ -0,0 +1,171 @@
+package browser
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/nlandolfi/browser/dom"
+	"golang.org/x/net/html"
+)
+
+// Batching {{{
+//
+// In the Batch mode, the Mounter does not call into the DOM per change.
+// Instead it encodes the change list from reconcileWalker as a JSON array
+// of ops and hands it to a Batcher, which applies it with one call into a
+// small JS interpreter (see js.NewBatch and the example's batch.js).
+//
+// Nodes are identified by integer ids, assigned by the Mounter as it
+// creates them; 0 refers to the Mounter's Root. Each op is an array whose
+// first element names it:
+//
+//	["c", id, tag]             create element
+//	["t", id, text]            create text node
+//	["a", parent, id]          append child
+//	["r", parent, new, old]    replace child
+//	["x", parent, id]          remove child
+//	["s", id, key, val]        set attribute (and the value property for "value")
+//	["d", id, key]             delete attribute
+//	["l", id, type]            listen: call the Batcher's Listen func for events of type
+//	["u", id, type]            unlisten
+//	["f", id]                  free: the id will no longer be referenced
+
+// A Batcher applies encoded change lists to the DOM. See the js package
+// for the implementation.
+type Batcher interface {
+	// Apply applies the ops, in order. Id 0 refers to root.
+	Apply(root dom.Element, ops []byte)
+
+	// Element returns the element created for the id, or nil.
+	// The Mounter uses it to draw canvases.
+	Element(id int) dom.Element
+
+	// Listen sets the function called for events on the nodes
+	// the ops have listened to.
+	Listen(func(id int, t dom.EventType, e dom.Event))
+}
+
+// batchedListener is stored in Handlers in place of an event listener;
+// the listener itself lives in the interpreter.
+type batchedListener struct{}
+
+func (batchedListener) Release() {}
+
+// batchRootID is the id of the Mounter's Root.
+const batchRootID = 0
+
+func (m *Mounter) mountBatch(n *Node) error {
+	if m.last == nil { // first mount
+		m.Batch.Listen(m.handleBatched)
+	}
+
+	changes := reconcileWalker(m.Root, m.last, n)
+	m.last = n
+
+	var (
+		ops   []interface{}
+		draws []*change
+	)
+	for _, c := range changes {
+		switch c.Type {
+		case insert:
+			if c.Ref.id == 0 {
+				ops = append(ops, m.createOp(c.Ref))
+			}
+			ops = append(ops, []interface{}{"a", batchID(c.Parent), c.Ref.id})
+		case replace:
+			ops = append(ops, m.createOp(c.Ref))
+			ops = append(ops, []interface{}{"r", batchID(c.Parent), c.Ref.id, c.Old.id})
+		case remove:
+			ops = append(ops, []interface{}{"x", batchID(c.Parent), c.Ref.id})
+		case attrSet:
+			ops = append(ops, []interface{}{"s", c.Ref.id, c.Key, c.Val})
+		case attrDelete:
+			ops = append(ops, []interface{}{"d", c.Ref.id, c.Key})
+		case listenerAdd:
+			c.Ref.Handlers.setListener(c.EventType, batchedListener{})
+			ops = append(ops, []interface{}{"l", c.Ref.id, string(c.EventType)})
+		case listenerDelete:
+			ops = append(ops, []interface{}{"u", c.Ref.id, string(c.EventType)})
+		case canvasDraw:
+			draws = append(draws, c) // once the canvas exists
+		default:
+			panic(fmt.Sprintf("unknown change type: %s", c.Type))
+		}
+	}
+
+	old := m.index(n)
+	var freed []int
+	for id := range old {
+		if _, ok := m.node(id); !ok {
+			freed = append(freed, id)
+		}
+	}
+	sort.Ints(freed)
+	for _, id := range freed {
+		ops = append(ops, []interface{}{"f", id})
+	}
+
+	if len(ops) > 0 {
+		bs, err := json.Marshal(ops)
+		if err != nil {
+			return fmt.Errorf("browser.Mount: encoding batch: %v", err)
+		}
+		m.Batch.Apply(m.Root, bs)
+	}
+
+	for _, c := range draws {
+		e := m.Batch.Element(c.Ref.id)
+		if e == nil {
+			return fmt.Errorf("browser.Mount: no element for canvas %d", c.Ref.id)
+		}
+		w, h := canvasSize(c.Ref)
+		c.CanvasDraw(e.CanvasContext(w, h, 10.0))
+	}
+
+	return nil
+}
+
+// createOp assigns n the next id and returns the op creating it.
+func (m *Mounter) createOp(n *Node) []interface{} {
+	m.nextID++
+	n.id = m.nextID
+
+	switch n.Type {
+	case html.ElementNode:
+		tagName := n.Data
+		if tagName == "" { // allow only defining the atom
+			tagName = n.DataAtom.String()
+		}
+		if tagName == "" {
+			panic("trying to mount ElementNode with empty tag name: must define DataAtom or Data (or both)")
+		}
+		return []interface{}{"c", n.id, tagName}
+	case html.TextNode:
+		return []interface{}{"t", n.id, n.Data}
+	default:
+		panic(fmt.Sprintf("unknown Node.Type: %#v", n.Type))
+	}
+}
+
+// batchID is the id of n, a parent; the faked parent of the root in
+// reconcileWalker has none, and is the Root.
+func batchID(n *Node) int {
+	if n.id == 0 {
+		return batchRootID
+	}
+	return n.id
+}
+
+func (m *Mounter) handleBatched(id int, t dom.EventType, e dom.Event) {
+	n, ok := m.node(id)
+	if !ok {
+		return // freed, but an event was in flight
+	}
+	if h := n.Handlers.Get(t); h != nil {
+		h(e)
+	}
+}
+
+// }}}
@@ -0,0 +1,108 @@
+package browser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// testBatch interprets ops against a domtest document, as batch.js does.
+type testBatch struct {
+	doc       *domtest.Document
+	nodes     map[int]dom.Node
+	listeners map[int]map[dom.EventType]dom.EventListener
+	handler   func(int, dom.EventType, dom.Event)
+	applies   int
+}
+
+func (b *testBatch) Apply(root dom.Element, bs []byte) {
+	b.applies++
+	var ops [][]interface{}
+	if err := json.Unmarshal(bs, &ops); err != nil {
+		panic(err)
+	}
+	get := func(v interface{}) dom.Node {
+		if id := int(v.(float64)); id != 0 {
+			return b.nodes[id]
+		}
+		return root
+	}
+	for _, op := range ops {
+		switch op[0] {
+		case "c":
+			b.nodes[int(op[1].(float64))] = b.doc.CreateElement(op[2].(string))
+		case "t":
+			b.nodes[int(op[1].(float64))] = b.doc.CreateTextNode(op[2].(string))
+		case "a":
+			get(op[1]).AppendChild(get(op[2]))
+		case "r":
+			get(op[1]).ReplaceChild(get(op[2]), get(op[3]))
+		case "x":
+			get(op[1]).RemoveChild(get(op[2]))
+		case "s":
+			get(op[1]).(dom.Element).SetAttribute(op[2].(string), op[3].(string))
+		case "d":
+			get(op[1]).(dom.Element).RemoveAttribute(op[2].(string))
+		case "l":
+			id, t := int(op[1].(float64)), dom.EventType(op[2].(string))
+			if b.listeners[id] == nil {
+				b.listeners[id] = make(map[dom.EventType]dom.EventListener)
+			}
+			b.listeners[id][t] = get(op[1]).AddEventListener(t, func(e dom.Event) { b.handler(id, t, e) })
+		case "u":
+			id, t := int(op[1].(float64)), dom.EventType(op[2].(string))
+			get(op[1]).RemoveEventListener(t, b.listeners[id][t])
+		case "f":
+			delete(b.nodes, int(op[1].(float64)))
+		default:
+			panic(op[0])
+		}
+	}
+}
+
+func (b *testBatch) Element(id int) dom.Element {
+	return b.nodes[id].(dom.Element)
+}
+
+func (b *testBatch) Listen(f func(int, dom.EventType, dom.Event)) { b.handler = f }
+
+func TestBatch(t *testing.T) {
+	d := domtest.NewDocument()
+	b := &testBatch{
+		doc:       d,
+		nodes:     make(map[int]dom.Node),
+		listeners: make(map[int]map[dom.EventType]dom.EventListener),
+	}
+	m := &Mounter{Document: d, Root: d.Body(), Batch: b}
+
+	clicks := 0
+	view := func(label string) *Node {
+		return (&Node{
+			Type:     html.ElementNode,
+			DataAtom: atom.Div,
+			Children: []*Node{{Type: html.TextNode, Data: label}},
+		}).ID("button").OnClick(func(dom.Event) { clicks++ })
+	}
+
+	for i, label := range []string{"one", "two"} {
+		if err := m.Mount(view(label)); err != nil {
+			t.Fatal(err)
+		}
+		if b.applies != i+1 {
+			t.Fatalf("mount %d: Apply called %d times, want %d", i, b.applies, i+1)
+		}
+		want := `<body><div id="button" style="">` + label + `</div></body>`
+		if got := d.BodyElement().HTML(); got != want {
+			t.Fatalf("mount %d: got %s, want %s", i, got, want)
+		}
+	}
+
+	d.BodyElement().Children()[0].Dispatch(&domtest.Event{Type: dom.Click})
+	if clicks != 1 {
+		t.Fatalf("got %d clicks, want 1", clicks)
+	}
+}
@@ -0,0 +1,164 @@
+// Package browsertest provides golden file testing of views: a view's
+// tree is formatted as stable, readable text, and compared with a file in
+// the testdata directory, e.g.,
+//
+//	func TestView(t *testing.T) {
+//		browsertest.Golden(t, "view", app.View(&app.State{}))
+//	}
+//
+// Run the tests with -update to write the golden files, then review the
+// changes to them as part of the change to the view.
+package browsertest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/a11y"
+	"github.com/nlandolfi/browser/internal/rules"
+	"golang.org/x/net/html"
+)
+
+// The -update flag is shared with any other package defining it first,
+// e.g., another golden file package, rather than defined twice.
+func init() {
+	if flag.Lookup("update") == nil {
+		flag.Bool("update", false, "update the golden files in testdata")
+	}
+}
+
+// updating reports whether the -update flag is set.
+func updating() bool {
+	f := flag.Lookup("update")
+	return f != nil && f.Value.String() == "true"
+}
+
+// Format formats the tree rooted at n as text: a line for each node, with
+// its children indented below it. An element's line has its tag and its
+// attributes, followed by lines for its style, the rules of its variants
+// and breakpoints, its handlers, and whether it has a CanvasDraw, e.g.,
+//
+//	button class="primary"
+//		style: padding:4.000000px;
+//		rule: &:hover{color:gray !important;}
+//		on: click
+//		"Save"
+//
+// A text node is a quoted string.
+func Format(n *browser.Node) string {
+	var b strings.Builder
+	format(&b, n, 0)
+	return b.String()
+}
+
+func format(b *strings.Builder, n *browser.Node, depth int) {
+	line := func(s string) {
+		b.WriteString(strings.Repeat("\t", depth))
+		b.WriteString(s)
+		b.WriteByte('\n')
+	}
+
+	switch n.Type {
+	case html.TextNode:
+		line(strconv.Quote(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		line(fmt.Sprintf("unknown node type %d", n.Type))
+		return
+	}
+
+	tag := n.Data
+	if tag == "" {
+		tag = n.DataAtom.String()
+	}
+	for _, a := range n.Attr {
+		key := a.Key
+		if a.Namespace != "" {
+			key = a.Namespace + ":" + key
+		}
+		tag += " " + key + "=" + strconv.Quote(a.Val)
+	}
+	line(tag)
+
+	depth++
+	if val := n.Style.Val(); val != "" {
+		line("style: " + val)
+	}
+	for _, r := range rules.Of(&n.Style, "&") {
+		line("rule: " + r)
+	}
+	if ts := n.Handlers.Types(); len(ts) > 0 {
+		on := make([]string, len(ts))
+		for i, t := range ts {
+			on[i] = string(t)
+		}
+		line("on: " + strings.Join(on, " "))
+	}
+	if n.CanvasDraw != nil {
+		line("canvas")
+	}
+	for _, c := range n.Children {
+		format(b, c, depth)
+	}
+}
+
+// Golden compares the format of the tree rooted at n with the golden
+// file testdata/<name>.golden, failing t if they differ. With -update,
+// it writes the golden file instead.
+func Golden(t testing.TB, name string, n *browser.Node) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name+".golden")
+	got := Format(n)
+	if updating() {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to write it)", err)
+	}
+	if got != string(want) {
+		i, g, w := firstDiff(got, string(want))
+		t.Errorf("%s differs at line %d (run go test -update to update it):\n got %s\nwant %s", path, i, g, w)
+	}
+}
+
+// firstDiff returns the number, from 1, and the lines, of the first line
+// where a and b differ.
+func firstDiff(a, b string) (int, string, string) {
+	as, bs := strings.Split(a, "\n"), strings.Split(b, "\n")
+	line := func(ls []string, i int) string {
+		if i < len(ls) {
+			return strconv.Quote(ls[i])
+		}
+		return "end of file"
+	}
+	i := 0
+	for i < len(as) && i < len(bs) && as[i] == bs[i] {
+		i++
+	}
+	return i + 1, line(as, i), line(bs, i)
+}
+
+// Accessible fails t with each accessibility issue of the tree rooted at
+// n, see package a11y.
+func Accessible(t testing.TB, n *browser.Node) {
+	t.Helper()
+	for _, issue := range a11y.Check(n) {
+		t.Error(issue)
+	}
+}
@@ -0,0 +1,36 @@
+package browsertest
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/ui"
+)
+
+func TestGolden(t *testing.T) {
+	view := ui.VStack(
+		ui.Div(ui.TextNode("Hello, \"world\"")).Class("greeting").PaddingPX(4),
+		ui.Div().
+			HoverStyle(browser.Style{Color: "gray"}).
+			Breakpoint(browser.BreakpointMD, browser.Style{Width: browser.PG(50)}).
+			OnClick(func(dom.Event) {}).
+			OnInput(func(dom.Event) {}),
+	)
+	Golden(t, "view", view)
+}
+
+func TestFirstDiff(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		line int
+	}{
+		{"a\nb\n", "a\nc\n", 2},
+		{"a\n", "a\nb\n", 2},
+		{"x", "y", 1},
+	} {
+		if line, _, _ := firstDiff(c.a, c.b); line != c.line {
+			t.Errorf("firstDiff(%q, %q): got line %d, want %d", c.a, c.b, line, c.line)
+		}
+	}
+}
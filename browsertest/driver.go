@@ -0,0 +1,204 @@
+package browsertest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+)
+
+// Driver {{{
+
+// A Driver runs user flows against a view, without a browser, e.g.,
+//
+//	var s app.State
+//	d := browsertest.NewDriver(t, func() *browser.Node { return app.View(&s) }, s.Handle)
+//	d.Type("input#name", "Ada")
+//	d.Click("#save")
+//	browsertest.Golden(t, "saved", d.Root())
+//
+// Each action finds a node of the current tree by selector, calls its
+// handler for the event, and those of its ancestors, as the event would
+// bubble in the DOM. Then it handles the events dispatched, and those they
+// dispatch, and builds the view again.
+//
+// The events are *domtest.Event, and their target is an element with the
+// tag and attributes of the node, and the value of the action.
+//
+// The Driver handles the events given to its Dispatch method, which the
+// view's handlers should dispatch through, e.g., with a dispatch func in
+// the app's state which is `go browser.Dispatch(e)` in the app, and set to
+// d.Dispatch in tests. Events sent on browser.Events, as by the
+// On...Dispatch handlers, aren't handled by the Driver, so drivers may run
+// in parallel.
+type Driver struct {
+	t      testing.TB
+	view   func() *browser.Node
+	handle func(browser.Event)
+	doc    *domtest.Document
+	root   *browser.Node
+
+	mu     sync.Mutex
+	queued []browser.Event
+}
+
+// NewDriver returns a driver of the view, with events handled by handle,
+// which may be nil. It builds the view.
+func NewDriver(t testing.TB, view func() *browser.Node, handle func(browser.Event)) *Driver {
+	d := &Driver{t: t, view: view, handle: handle, doc: domtest.NewDocument()}
+	d.root = view()
+	return d
+}
+
+// Root returns the current tree.
+func (d *Driver) Root() *browser.Node { return d.root }
+
+// Find returns the first node of the current tree matching the selector,
+// failing the test if there is none.
+func (d *Driver) Find(selector string) *browser.Node {
+	d.t.Helper()
+	path := d.find(selector)
+	return path[len(path)-1]
+}
+
+// find returns the first node matching the selector, after its ancestors.
+func (d *Driver) find(selector string) []*browser.Node {
+	d.t.Helper()
+	s, err := browser.ParseSelector(selector)
+	if err != nil {
+		d.t.Fatal(err)
+	}
+
+	var (
+		path, found []*browser.Node
+		visit       func(n *browser.Node) bool
+	)
+	visit = func(n *browser.Node) bool {
+		if s.Match(n, path) {
+			found = append(path, n)
+			return true
+		}
+		path = append(path, n)
+		for _, c := range n.Children {
+			if visit(c) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		return false
+	}
+	if !visit(d.root) {
+		d.t.Fatalf("browsertest: no node matches %q", selector)
+	}
+	return found
+}
+
+// Click clicks the node.
+func (d *Driver) Click(selector string) {
+	d.t.Helper()
+	d.Fire(selector, &domtest.Event{Type: dom.Click})
+}
+
+// Type sets the value of the node, as if the user typed it, firing an
+// input event.
+func (d *Driver) Type(selector, value string) {
+	d.t.Helper()
+	d.fire(selector, &domtest.Event{Type: dom.Input}, &value)
+}
+
+// KeyUp releases the key, with the key code (e.g., 13 for enter) and
+// code (e.g., "Enter"), on the node.
+func (d *Driver) KeyUp(selector string, keyCode int, code string) {
+	d.t.Helper()
+	d.Fire(selector, &domtest.Event{Type: dom.KeyUp, Key: keyCode, KeyName: code})
+}
+
+// MouseDown presses the mouse at the coordinates, on the node.
+func (d *Driver) MouseDown(selector string, x, y int) {
+	d.t.Helper()
+	d.Fire(selector, &domtest.Event{Type: dom.MouseDown, X: x, Y: y})
+}
+
+// Fire fires the event at the node. The target's value is the node's
+// value attribute, unless ev has a target.
+func (d *Driver) Fire(selector string, ev *domtest.Event) {
+	d.t.Helper()
+	d.fire(selector, ev, nil)
+}
+
+func (d *Driver) fire(selector string, ev *domtest.Event, value *string) {
+	d.t.Helper()
+	path := d.find(selector)
+	n := path[len(path)-1]
+
+	if ev.Src == nil {
+		ev.Src = d.target(n, value)
+	}
+
+	handled := false
+	for i := len(path) - 1; i >= 0 && !ev.Stopped; i-- {
+		if h := path[i].Handlers.Get(ev.Type); h != nil {
+			h(ev)
+			handled = true
+		}
+		if ev.Type == dom.MouseEnter || ev.Type == dom.MouseLeave {
+			break // these don't bubble
+		}
+	}
+	if !handled {
+		d.t.Fatalf("browsertest: no %s handler for %q", ev.Type, selector)
+	}
+
+	d.drain()
+	d.root = d.view()
+}
+
+// target returns an element like n, with the value.
+func (d *Driver) target(n *browser.Node, value *string) *domtest.Element {
+	tag := n.Data
+	if tag == "" {
+		tag = n.DataAtom.String()
+	}
+	el := d.doc.CreateElement(tag).(*domtest.Element)
+	for _, a := range n.Attr {
+		el.SetAttribute(a.Key, a.Val)
+		if a.Key == "value" {
+			el.SetValue(a.Val)
+		}
+	}
+	if value != nil {
+		el.SetValue(*value)
+	}
+	return el
+}
+
+// Dispatch queues e, to be handled once the handlers of the action in
+// progress return. It may be called from any goroutine, but an event
+// queued after the action has returned is handled by the next action.
+func (d *Driver) Dispatch(e browser.Event) {
+	d.mu.Lock()
+	d.queued = append(d.queued, e)
+	d.mu.Unlock()
+}
+
+// drain handles the events queued, in order, until there are none.
+func (d *Driver) drain() {
+	for {
+		d.mu.Lock()
+		if len(d.queued) == 0 {
+			d.mu.Unlock()
+			return
+		}
+		e := d.queued[0]
+		d.queued = d.queued[1:]
+		d.mu.Unlock()
+
+		if d.handle != nil {
+			d.handle(e)
+		}
+	}
+}
+
+// }}}
@@ -0,0 +1,71 @@
+package browsertest
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/ui"
+)
+
+type signup struct {
+	Name, Saved   string
+	Keys, Notices int
+
+	dispatch func(browser.Event)
+}
+
+type (
+	saved  struct{}
+	notice struct{}
+)
+
+func (s *signup) Handle(e browser.Event) {
+	switch e.(type) {
+	case saved:
+		s.Saved = s.Name
+		s.dispatch(notice{})
+	case notice:
+		s.Notices++
+	}
+}
+
+func (s *signup) View() *browser.Node {
+	return ui.VStack(
+		ui.TextInput(&s.Name).ID("name").OnKeyUp(func(e dom.Event) { s.Keys += e.KeyCode() }),
+		ui.OnlyIf(s.Saved != "", func() *browser.Node { return ui.TextNode("Saved " + s.Saved) }),
+		ui.Button("Save").ID("save").OnClick(func(dom.Event) { s.dispatch(saved{}) }),
+	).Class("form")
+}
+
+func TestDriver(t *testing.T) {
+	t.Parallel()
+
+	var s signup
+	d := NewDriver(t, s.View, s.Handle)
+	s.dispatch = d.Dispatch
+
+	d.Type("input#name", "Ada")
+	if s.Name != "Ada" {
+		t.Errorf("name: got %q, want Ada", s.Name)
+	}
+	if v, _ := d.Find(".form #name").GetAttr("value"); v != "Ada" {
+		t.Errorf("value attribute: got %q, want the view built again", v)
+	}
+
+	d.KeyUp("#name", 13, "Enter")
+	if s.Keys != 13 {
+		t.Errorf("key code: got %d, want 13", s.Keys)
+	}
+
+	d.Click("#save")
+	if s.Saved != "Ada" {
+		t.Errorf("saved: got %q, want the dispatched event handled", s.Saved)
+	}
+	if s.Notices != 1 {
+		t.Errorf("notices: got %d, want the event dispatched by the handler handled", s.Notices)
+	}
+	if got := d.Root().Children[1].Data; got != "Saved Ada" {
+		t.Errorf("view: got %q, want it built again", got)
+	}
+}
@@ -0,0 +1,270 @@
+package browser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A Color is an sRGB color, with an alpha from 0 (transparent) to 1.
+//
+// Style's colors (e.g., Color, BackgroundColor, Border.Color and
+// BoxShadow.Color) are strings, as they may also be keywords, e.g.,
+// currentColor, or var() references, which a Color can't be. The color
+// properties have builders taking a Color, e.g., ColorOf; otherwise,
+// pass a Color with String. Read one back with ParseColor, e.g.,
+//
+//	accent := browser.MustParseColor("rgb(66,196,208)")
+//	n.ColorOf(accent).BackgroundColorOf(accent.Darken(0.1))
+//	n.Border(browser.Border{Type: browser.BorderSolid, Color: accent.String()})
+type Color struct {
+	R, G, B uint8
+	A       float64
+}
+
+// RGB returns the opaque color.
+func RGB(r, g, b uint8) Color { return Color{R: r, G: g, B: b, A: 1} }
+
+// HSL returns the opaque color with the hue, in degrees, and the
+// saturation and lightness, from 0 to 1.
+func HSL(h, s, l float64) Color {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	s, l = clamp01(s), clamp01(l)
+
+	// https://www.w3.org/TR/css-color-4/#hsl-to-rgb
+	f := func(n float64) uint8 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		return channel(l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1))))
+	}
+	return RGB(f(0), f(8), f(4))
+}
+
+// HSL returns the hue, in degrees, and the saturation and lightness,
+// from 0 to 1, of c.
+func (c Color) HSL() (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+	d := max - min
+	if d == 0 {
+		return 0, 0, l
+	}
+
+	if l > 0 && l < 1 {
+		s = d / (1 - math.Abs(2*l-1))
+	}
+	switch max {
+	case r:
+		h = math.Mod((g-b)/d, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+	return h, s, l
+}
+
+// String returns the color as #rrggbb, or, if it is not opaque, rgba().
+func (c Color) String() string {
+	if c.A >= 1 {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+
+	a := strconv.FormatFloat(math.Round(clamp01(c.A)*1000)/1000, 'f', -1, 64)
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", c.R, c.G, c.B, a)
+}
+
+// Manipulation {{{
+
+// Lighten returns c with its lightness increased by the amount, from 0 to 1.
+func (c Color) Lighten(amount float64) Color {
+	h, s, l := c.HSL()
+	return HSL(h, s, l+amount).Alpha(c.A)
+}
+
+// Darken returns c with its lightness decreased by the amount, from 0 to 1.
+func (c Color) Darken(amount float64) Color { return c.Lighten(-amount) }
+
+// Alpha returns c with the alpha.
+func (c Color) Alpha(a float64) Color {
+	c.A = clamp01(a)
+	return c
+}
+
+// Mix returns the mix of c with the weight, from 0 to 1, of d, e.g.,
+// 0.5 for equal parts.
+func (c Color) Mix(d Color, weight float64) Color {
+	w := clamp01(weight)
+	mix := func(x, y float64) float64 { return x*(1-w) + y*w }
+	return Color{
+		R: channel(mix(float64(c.R), float64(d.R)) / 255),
+		G: channel(mix(float64(c.G), float64(d.G)) / 255),
+		B: channel(mix(float64(c.B), float64(d.B)) / 255),
+		A: mix(c.A, d.A),
+	}
+}
+
+// }}}
+
+// Contrast {{{
+
+// Luminance returns the WCAG relative luminance of c, from 0 for black
+// to 1 for white, ignoring its alpha.
+func (c Color) Luminance() float64 {
+	// https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+	lin := func(v uint8) float64 {
+		x := float64(v) / 255
+		if x <= 0.03928 {
+			return x / 12.92
+		}
+		return math.Pow((x+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(c.R) + 0.7152*lin(c.G) + 0.0722*lin(c.B)
+}
+
+// Contrast returns the WCAG contrast ratio of c and d, from 1 to 21.
+// WCAG AA asks for at least 4.5 for text, or 3 for large text.
+func (c Color) Contrast(d Color) float64 {
+	a, b := c.Luminance(), d.Luminance()
+	if a < b {
+		a, b = b, a
+	}
+	return (a + 0.05) / (b + 0.05)
+}
+
+// }}}
+
+// Parsing {{{
+
+// ParseColor parses a CSS color: #rgb, #rgba, #rrggbb, #rrggbbaa, rgb(),
+// rgba(), hsl(), hsla(), or a named color, e.g., antiquewhite.
+func ParseColor(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if c, ok := namedColors[s]; ok {
+		return c, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		return parseHex(s)
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return Color{}, fmt.Errorf("browser.ParseColor: unknown color %q", s)
+	}
+	name, args := s[:open], colorArgs(s[open+1:len(s)-1])
+	if len(args) != 3 && len(args) != 4 {
+		return Color{}, fmt.Errorf("browser.ParseColor: %q: want 3 or 4 arguments, got %d", s, len(args))
+	}
+
+	a := 1.0
+	if len(args) == 4 {
+		v, err := parseNumber(args[3], 1)
+		if err != nil {
+			return Color{}, fmt.Errorf("browser.ParseColor: %q: alpha: %v", s, err)
+		}
+		a = clamp01(v)
+	}
+
+	switch name {
+	case "rgb", "rgba":
+		var rgb [3]uint8
+		for i := range rgb {
+			v, err := parseNumber(args[i], 255)
+			if err != nil {
+				return Color{}, fmt.Errorf("browser.ParseColor: %q: %v", s, err)
+			}
+			rgb[i] = channel(v / 255)
+		}
+		return Color{R: rgb[0], G: rgb[1], B: rgb[2], A: a}, nil
+	case "hsl", "hsla":
+		h, err := parseNumber(strings.TrimSuffix(args[0], "deg"), 0)
+		if err != nil {
+			return Color{}, fmt.Errorf("browser.ParseColor: %q: hue: %v", s, err)
+		}
+		var sl [2]float64
+		for i := range sl {
+			if !strings.HasSuffix(args[i+1], "%") {
+				return Color{}, fmt.Errorf("browser.ParseColor: %q: want a percentage, got %q", s, args[i+1])
+			}
+			if sl[i], err = parseNumber(args[i+1], 1); err != nil {
+				return Color{}, fmt.Errorf("browser.ParseColor: %q: %v", s, err)
+			}
+		}
+		return HSL(h, sl[0], sl[1]).Alpha(a), nil
+	}
+
+	return Color{}, fmt.Errorf("browser.ParseColor: unknown color function %q", name)
+}
+
+// MustParseColor is like ParseColor, but panics if s is not a color.
+func MustParseColor(s string) Color {
+	c, err := ParseColor(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func parseHex(s string) (Color, error) {
+	h := s[1:]
+	if len(h) == 3 || len(h) == 4 { // #rgb(a) is #rrggbb(aa)
+		var b strings.Builder
+		for i := 0; i < len(h); i++ {
+			b.WriteByte(h[i])
+			b.WriteByte(h[i])
+		}
+		h = b.String()
+	}
+	if len(h) != 6 && len(h) != 8 {
+		return Color{}, fmt.Errorf("browser.ParseColor: bad hex color %q", s)
+	}
+
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("browser.ParseColor: bad hex color %q", s)
+	}
+	if len(h) == 6 {
+		v = v<<8 | 0xff
+	}
+	return Color{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: float64(uint8(v)) / 255}, nil
+}
+
+// colorArgs splits the arguments of a color function, in either the
+// legacy "r, g, b, a" or the modern "r g b / a" syntax.
+func colorArgs(s string) []string {
+	s = strings.NewReplacer(",", " ", "/", " ").Replace(s)
+	return strings.Fields(s)
+}
+
+// parseNumber parses a number, or a percentage of max.
+func parseNumber(s string, max float64) (float64, error) {
+	p := strings.TrimSuffix(s, "%")
+	v, err := strconv.ParseFloat(p, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("bad number %q", s)
+	}
+	if p != s {
+		v = v / 100 * max
+	}
+	return v, nil
+}
+
+// }}}
+
+// channel converts v, from 0 to 1, to a channel value.
+func channel(v float64) uint8 { return uint8(math.Round(clamp01(v) * 255)) }
+
+func clamp01(v float64) float64 { return math.Max(0, math.Min(1, v)) }
@@ -0,0 +1,74 @@
+package browser
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	for _, c := range []struct {
+		in   string
+		want Color
+	}{
+		{"#fff", RGB(255, 255, 255)},
+		{"#42C4D0", RGB(66, 196, 208)},
+		{"#00000080", Color{A: 128.0 / 255}},
+		{"rgb(66,196,208)", RGB(66, 196, 208)},
+		{"rgba(0, 0, 0, 0.1)", Color{A: 0.1}},
+		{"rgb(100% 0% 0% / 50%)", Color{R: 255, A: 0.5}},
+		{"hsl(120, 100%, 25%)", RGB(0, 128, 0)},
+		{"hsla(240deg, 100%, 50%, 1)", RGB(0, 0, 255)},
+		{"AntiqueWhite", RGB(250, 235, 215)},
+		{"transparent", Color{}},
+	} {
+		got, err := ParseColor(c.in)
+		if err != nil {
+			t.Errorf("ParseColor(%q): %v", c.in, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("ParseColor(%q): got %v, want %v", c.in, got, c.want)
+		}
+	}
+
+	for _, in := range []string{"", "#ggg", "#12345", "rgb(1,2)", "rgb(a,b,c)", "cmyk(1,2,3,4)", "notacolor"} {
+		if _, err := ParseColor(in); err == nil {
+			t.Errorf("ParseColor(%q): want an error", in)
+		}
+	}
+}
+
+func TestColor(t *testing.T) {
+	gray := RGB(128, 128, 128)
+
+	if got, want := gray.Lighten(0.1).String(), "#9a9a9a"; got != want {
+		t.Errorf("Lighten: got %s, want %s", got, want)
+	}
+	if got, want := gray.Darken(1).String(), "#000000"; got != want {
+		t.Errorf("Darken: got %s, want %s", got, want)
+	}
+	if got, want := gray.Alpha(0.25).String(), "rgba(128, 128, 128, 0.25)"; got != want {
+		t.Errorf("Alpha: got %s, want %s", got, want)
+	}
+	if got, want := RGB(0, 0, 0).Mix(RGB(255, 255, 255), 0.5), RGB(128, 128, 128); got != want {
+		t.Errorf("Mix: got %v, want %v", got, want)
+	}
+
+	if got := RGB(0, 0, 0).Contrast(RGB(255, 255, 255)); got != 21 {
+		t.Errorf("Contrast(black, white): got %v, want 21", got)
+	}
+	// #767676 is the lightest gray with AA contrast on white
+	if got := RGB(0x76, 0x76, 0x76).Contrast(RGB(255, 255, 255)); math.Abs(got-4.54) > 0.01 {
+		t.Errorf("Contrast(#767676, white): got %v, want 4.54", got)
+	}
+}
+
+func TestColorBuilders(t *testing.T) {
+	c := RGB(66, 196, 208).Alpha(0.5)
+
+	typed := (&Node{}).ColorOf(c).BackgroundColorOf(c).BorderColorOf(c)
+	strs := (&Node{}).Color(c.String()).BackgroundColor(c.String()).BorderColor(c.String())
+	if got, want := typed.Style.Val(), strs.Style.Val(); got != want || got == "" {
+		t.Errorf("Val: got %q, want %q", got, want)
+	}
+}
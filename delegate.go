@@ -0,0 +1,154 @@
+package browser
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/nlandolfi/browser/dom"
+	"golang.org/x/net/html"
+)
+
+// Event delegation {{{
+//
+// In the Delegate mode, the Mounter gives each element it creates an id,
+// stored in the delegateAttr attribute, and keeps an index from id to the
+// *Node currently rendered by that element. The ids are namespaced by the
+// Mounter, e.g., "2.17", so that the Root of one Mounter may be inside
+// the tree of another. Rather than adding a listener
+// to an element, it adds (once) a listener for the event type to Root.
+// When an event reaches Root, we walk from its target up through the
+// parent elements, calling each node's current handler for the type, as
+// the browser would have had the listeners been on the elements.
+//
+// Events which do not bubble (mouseenter, mouseleave) never reach Root,
+// so they, and any listeners on text nodes, are still added directly.
+
+// delegateAttr is the attribute holding the Mounter's id for an element.
+const delegateAttr = "data-browser-id"
+
+// mounters numbers the Mounters in the Delegate mode, for their namespaces.
+var mounters atomic.Uint64
+
+// bubbles reports whether events of the type bubble to their ancestors.
+func bubbles(t dom.EventType) bool {
+	switch t {
+	case dom.MouseEnter, dom.MouseLeave:
+		return false
+	}
+	return true
+}
+
+// delegatedListener is stored in Handlers in place of the listener
+// that would have been added to the element.
+type delegatedListener struct{}
+
+func (delegatedListener) Release() {}
+
+// identify assigns the next id to the (newly created) element of n.
+func (m *Mounter) identify(n *Node) {
+	m.nextID++
+	n.id = m.nextID
+
+	m.mu.Lock()
+	if m.ns == "" {
+		m.ns = strconv.FormatUint(mounters.Add(1), 10) + "."
+	}
+	ns := m.ns
+	m.mu.Unlock()
+	n.renderedElement.SetAttribute(delegateAttr, ns+strconv.Itoa(n.id))
+}
+
+// delegate adds the listener for the event type to Root, if it has not yet been added.
+func (m *Mounter) delegate(t dom.EventType) {
+	if m.delegated == nil {
+		m.delegated = make(map[dom.EventType]dom.EventListener)
+	}
+	if _, ok := m.delegated[t]; ok {
+		return
+	}
+
+	m.delegated[t] = m.Root.AddEventListener(t, func(e dom.Event) {
+		m.route(t, e)
+	})
+}
+
+// index rebuilds the map from id to the nodes of the tree rooted at n.
+// It returns the previous map.
+func (m *Mounter) index(n *Node) (old map[int]*Node) {
+	nodes := make(map[int]*Node, len(m.nodes))
+
+	var walk func(*Node)
+	walk = func(n *Node) {
+		if n.id != 0 {
+			nodes[n.id] = n
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(n)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	old, m.nodes = m.nodes, nodes
+	return old
+}
+
+// node returns the node with the id, if it is in the last mounted tree.
+func (m *Mounter) node(id int) (*Node, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.nodes[id]
+	return n, ok
+}
+
+// delegatedNode returns the node with the id in the delegateAttr
+// attribute, if the id is one of m's and the node is in the last mounted
+// tree.
+func (m *Mounter) delegatedNode(attr string) (*Node, bool) {
+	m.mu.Lock()
+	ns := m.ns
+	m.mu.Unlock()
+
+	v, ok := strings.CutPrefix(attr, ns)
+	if !ok || ns == "" {
+		return nil, false
+	}
+	id, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, false
+	}
+	return m.node(id)
+}
+
+// route calls the handlers for e, bubbling from the target to Root.
+func (m *Mounter) route(t dom.EventType, e dom.Event) {
+	de := &delegatedEvent{Event: e}
+
+	for el := e.Target(); el != nil; el = el.ParentElement() {
+		n, ok := m.delegatedNode(el.GetAttribute(delegateAttr))
+		if !ok || n.Type != html.ElementNode {
+			continue // not one of ours
+		}
+		if h := n.Handlers.Get(t); h != nil {
+			h(de)
+			if de.stopped {
+				return
+			}
+		}
+	}
+}
+
+// delegatedEvent records StopPropagation, so that route stops bubbling.
+type delegatedEvent struct {
+	dom.Event
+	stopped bool
+}
+
+func (e *delegatedEvent) StopPropagation() {
+	e.stopped = true
+	e.Event.StopPropagation()
+}
+
+// }}}
@@ -0,0 +1,95 @@
+package browser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestDelegate(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body(), Delegate: true}
+
+	var got []string
+	view := func(stop bool) *Node {
+		inner := &Node{Type: html.ElementNode, DataAtom: atom.Span}
+		inner.OnClick(func(e dom.Event) {
+			got = append(got, "inner")
+			if stop {
+				e.StopPropagation()
+			}
+		})
+		outer := &Node{Type: html.ElementNode, DataAtom: atom.Div, Children: []*Node{inner}}
+		return outer.OnClick(func(e dom.Event) { got = append(got, "outer") })
+	}
+
+	for i, stop := range []bool{false, true} {
+		if err := m.Mount(view(stop)); err != nil {
+			t.Fatal(err)
+		}
+
+		body := d.BodyElement()
+		if n := body.Listeners(dom.Click); n != 1 {
+			t.Fatalf("mount %d: root has %d click listeners, want 1", i, n)
+		}
+		div := body.Children()[0]
+		if n := div.Listeners(dom.Click); n != 0 {
+			t.Fatalf("mount %d: div has %d click listeners, want 0", i, n)
+		}
+
+		got = nil
+		div.Children()[0].Dispatch(&domtest.Event{Type: dom.Click})
+		want := []string{"inner", "outer"}
+		if stop {
+			want = want[:1]
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("mount %d: handlers called %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestDelegateNested(t *testing.T) {
+	d := domtest.NewDocument()
+
+	var got []string
+	handler := func(name string, stop bool) func(dom.Event) {
+		return func(e dom.Event) {
+			got = append(got, name)
+			if stop {
+				e.StopPropagation()
+			}
+		}
+	}
+
+	// the inner Mounter's Root is the element of the outer's div, and
+	// both number their elements from 1
+	outer := &Mounter{Document: d, Root: d.Body(), Delegate: true}
+	div := (&Node{Type: html.ElementNode, DataAtom: atom.Div}).OnClick(handler("outer", false))
+	if err := outer.Mount(div); err != nil {
+		t.Fatal(err)
+	}
+	host := d.BodyElement().Children()[0]
+	inner := &Mounter{Document: d, Root: host, Delegate: true}
+
+	for _, stop := range []bool{false, true} {
+		span := (&Node{Type: html.ElementNode, DataAtom: atom.Span}).OnClick(handler("inner", stop))
+		if err := inner.Mount(span); err != nil {
+			t.Fatal(err)
+		}
+
+		got = nil
+		host.Children()[0].Dispatch(&domtest.Event{Type: dom.Click})
+		want := "inner,outer"
+		if stop {
+			want = "inner"
+		}
+		if strings.Join(got, ",") != want {
+			t.Errorf("stop %t: handlers called %v, want %s", stop, got, want)
+		}
+	}
+}
@@ -18,6 +18,10 @@ type Document interface {
 	// See: https://developer.mozilla.org/en-US/docs/Web/API/Document/body.
 	Body() Element
 
+	// Head is the document's head, as in the javascript `document.head`.
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/Document/head.
+	Head() Element
+
 	// GetElementByID retrieves a DOM element by its id, as in the javascript `document.getElementById`.
 	// See: https://developer.mozilla.org/en-US/docs/Web/API/Document/getElementById.
 	GetElementByID(string) (Element, error)
@@ -121,6 +125,10 @@ type Element interface {
 
 	SetAttribute(key, val string)
 
+	// GetAttribute returns the attribute's value, or "" if it is not set.
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/Element/getAttribute.
+	GetAttribute(key string) string
+
 	RemoveAttribute(key string)
 
 	SetStyle(attr, val string)
@@ -140,6 +148,38 @@ type Element interface {
 	Node
 }
 
+// A Rect is a box in CSS pixels.
+// See: https://developer.mozilla.org/en-US/docs/Web/API/DOMRect.
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
+// Bounded is implemented by elements which can report their box, e.g.,
+// to map an event's client coordinates into the element's.
+type Bounded interface {
+	// BoundingClientRect returns the element's box relative to the viewport,
+	// as in the javascript `element.getBoundingClientRect()`.
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/Element/getBoundingClientRect.
+	BoundingClientRect() Rect
+}
+
+// StyleSheet is implemented by <style> elements whose rules can be
+// changed one at a time, through their `sheet`, rather than by setting
+// their text.
+// See: https://developer.mozilla.org/en-US/docs/Web/API/CSSStyleSheet.
+type StyleSheet interface {
+	// InsertRule inserts the rule at the index, as in the javascript
+	// `style.sheet.insertRule(rule, index)`. It returns an error if the
+	// rule can't be parsed, or the index is out of range.
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/CSSStyleSheet/insertRule.
+	InsertRule(rule string, index int) error
+
+	// DeleteRule deletes the rule at the index, as in the javascript
+	// `style.sheet.deleteRule(index)`.
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/CSSStyleSheet/deleteRule.
+	DeleteRule(index int)
+}
+
 // ElemResolver finds a DOM element by it's ID
 //type ElemResolver func(id string) (Element, error)
 
@@ -165,5 +205,41 @@ const (
 	DragOver              = "dragover"
 )
 
+// CanvasRenderingContext2D exposes a subset of functionality of a CanvasRenderingContext2D object.
+// See: https://developer.mozilla.org/en-US/docs/Web/API/CanvasRenderingContext2D.
 type CanvasRenderingContext2D interface {
+	Save()
+	Restore()
+
+	// Transform multiplies the current transformation by the matrix
+	//   [a c e]
+	//   [b d f]
+	//   [0 0 1]
+	// See: https://developer.mozilla.org/en-US/docs/Web/API/CanvasRenderingContext2D/transform.
+	Transform(a, b, c, d, e, f float64)
+
+	SetFillStyle(s string)
+	SetStrokeStyle(s string)
+	SetLineWidth(w float64)
+	SetFont(s string)
+	SetGlobalAlpha(a float64)
+
+	ClearRect(x, y, w, h float64)
+	FillRect(x, y, w, h float64)
+	StrokeRect(x, y, w, h float64)
+
+	BeginPath()
+	ClosePath()
+	MoveTo(x, y float64)
+	LineTo(x, y float64)
+	Arc(x, y, r, start, end float64)
+	Fill()
+	Stroke()
+
+	FillText(s string, x, y float64)
+	// MeasureText returns the width of s in the current font.
+	MeasureText(s string) float64
+
+	// DrawImage draws an image-like element (e.g., img, canvas) scaled to the box.
+	DrawImage(img Element, x, y, w, h float64)
 }
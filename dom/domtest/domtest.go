@@ -0,0 +1,518 @@
+// Package domtest provides an in-memory implementation of the dom
+// interfaces, for testing code (e.g., the browser.Mounter) without a browser.
+//
+// Only as much of the DOM is modeled as the browser package uses: the
+// tree, attributes, styles, values, listeners and style sheets. Events bubble from
+// their target to the root, like most DOM events.
+//
+// A Document and its elements are safe for concurrent use, as the
+// browser's are, so that tests exercising the Mounter alongside event
+// handlers can be run with -race. Listeners are called without the
+// document's lock held.
+package domtest
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/nlandolfi/browser/dom"
+)
+
+var (
+	// this type checks all the implementations
+	_ dom.Document      = (*Document)(nil)
+	_ dom.Element       = (*Element)(nil)
+	_ dom.Event         = (*Event)(nil)
+	_ dom.EventListener = (*listener)(nil)
+	_ dom.StyleSheet    = (*Element)(nil)
+)
+
+// Document {{{
+
+type Document struct {
+	// mu guards the document and all of its elements
+	mu         sync.Mutex
+	head, body *Element
+
+	listeners
+}
+
+func NewDocument() *Document {
+	d := new(Document)
+	d.listeners.mu = &d.mu
+	d.head = d.newElement("head")
+	d.body = d.newElement("body")
+	return d
+}
+
+func (d *Document) ReadyState() string { return "complete" }
+func (d *Document) Body() dom.Element  { return d.body }
+
+// BodyElement is Body, but returns the concrete type.
+func (d *Document) BodyElement() *Element { return d.body }
+
+func (d *Document) Head() dom.Element { return d.head }
+
+// HeadElement is Head, but returns the concrete type.
+func (d *Document) HeadElement() *Element { return d.head }
+
+func (d *Document) GetElementByID(id string) (dom.Element, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if e := d.body.find(id); e != nil {
+		return e, nil
+	}
+	return nil, fmt.Errorf("element not found")
+}
+
+func (d *Document) CreateElement(tag string) dom.Element { return d.newElement(tag) }
+
+func (d *Document) CreateTextNode(s string) dom.Text {
+	e := d.newElement("")
+	e.text = s
+	return e
+}
+
+func (d *Document) Selection() dom.Selection { return nil }
+
+// }}}
+
+// Element {{{
+
+// Element is both the dom.Element and, with an empty Tag, the dom.Text
+// implementation.
+type Element struct {
+	Tag string
+
+	text      string
+	innerHTML template.HTML
+	value     string
+	attrs     map[string]string
+	style     map[string]string
+	children  []*Element
+	parent    *Element
+
+	selectionStart, selectionEnd int
+
+	listeners
+
+	// Canvas records the calls made to the element's canvas context.
+	Canvas *Canvas
+
+	// Box is the element's BoundingClientRect; there is no layout.
+	Box dom.Rect
+
+	// the rules inserted into the element's sheet
+	rules []string
+}
+
+func (d *Document) newElement(tag string) *Element {
+	return &Element{
+		Tag:       tag,
+		attrs:     make(map[string]string),
+		style:     make(map[string]string),
+		listeners: listeners{mu: &d.mu},
+	}
+}
+
+func (e *Element) lock() func() {
+	e.mu.Lock()
+	return e.mu.Unlock
+}
+
+func mustElement(n dom.Node) *Element {
+	e, ok := n.(*Element)
+	if !ok {
+		panic("must be *domtest.Element")
+	}
+	return e
+}
+
+func (e *Element) IsText() bool { return e.Tag == "" }
+
+// Text returns the data of a text node, or the concatenated text of an element's descendants.
+func (e *Element) Text() string {
+	defer e.lock()()
+	return e.textContent()
+}
+
+func (e *Element) textContent() string {
+	if e.IsText() {
+		return e.text
+	}
+	var buf bytes.Buffer
+	for _, c := range e.children {
+		buf.WriteString(c.textContent())
+	}
+	return buf.String()
+}
+
+func (e *Element) Children() []*Element {
+	defer e.lock()()
+	return append([]*Element(nil), e.children...)
+}
+
+func (e *Element) Parent() *Element {
+	defer e.lock()()
+	return e.parent
+}
+
+// Attr returns the value of the attribute, and whether it is set.
+func (e *Element) Attr(key string) (string, bool) {
+	defer e.lock()()
+	v, ok := e.attrs[key]
+	return v, ok
+}
+
+func (e *Element) Style(attr string) string {
+	defer e.lock()()
+	return e.style[attr]
+}
+
+func (e *Element) SetInnerHTML(s template.HTML) {
+	defer e.lock()()
+	for _, c := range e.children {
+		c.parent = nil
+	}
+	e.children = nil
+	e.innerHTML = s
+	e.rules = nil
+}
+
+func (e *Element) SetAttribute(key, val string)   { defer e.lock()(); e.attrs[key] = val }
+func (e *Element) GetAttribute(key string) string { defer e.lock()(); return e.attrs[key] }
+func (e *Element) RemoveAttribute(key string)     { defer e.lock()(); delete(e.attrs, key) }
+func (e *Element) SetStyle(attr, val string)      { defer e.lock()(); e.style[attr] = val }
+func (e *Element) RemoveStyle(attr string)        { defer e.lock()(); delete(e.style, attr) }
+func (e *Element) SetValue(s string)              { defer e.lock()(); e.value = s }
+func (e *Element) Value() string                  { defer e.lock()(); return e.value }
+func (e *Element) SetSelectionStart(i int)        { defer e.lock()(); e.selectionStart = i }
+func (e *Element) SelectionStart() int            { defer e.lock()(); return e.selectionStart }
+func (e *Element) SetSelectionEnd(i int)          { defer e.lock()(); e.selectionEnd = i }
+func (e *Element) SelectionEnd() int              { defer e.lock()(); return e.selectionEnd }
+func (e *Element) LogSelf()                       { fmt.Println(e.HTML()) }
+func (e *Element) ParentElement() dom.Element {
+	defer e.lock()()
+	if e.parent == nil {
+		return nil
+	}
+	return e.parent
+}
+
+func (e *Element) BoundingClientRect() dom.Rect { defer e.lock()(); return e.Box }
+
+// InsertRule inserts the rule into the element's sheet. The sheet's rules
+// are encoded after the element's inner HTML, one per line. Rules which
+// aren't a block, e.g., "color:red", are rejected, as are out of range
+// indexes.
+func (e *Element) InsertRule(rule string, index int) error {
+	defer e.lock()()
+	if index < 0 || index > len(e.rules) {
+		return fmt.Errorf("domtest: rule index %d out of range", index)
+	}
+	if !strings.Contains(rule, "{") || !strings.HasSuffix(rule, "}") {
+		return fmt.Errorf("domtest: invalid rule %q", rule)
+	}
+	e.rules = append(e.rules[:index], append([]string{rule}, e.rules[index:]...)...)
+	return nil
+}
+
+func (e *Element) DeleteRule(index int) {
+	defer e.lock()()
+	e.rules = append(e.rules[:index], e.rules[index+1:]...)
+}
+
+// Rules returns the rules inserted into the element's sheet.
+func (e *Element) Rules() []string {
+	defer e.lock()()
+	return append([]string(nil), e.rules...)
+}
+
+// CanvasContext returns the element's Canvas. Canvases are not locked;
+// draw from one goroutine.
+func (e *Element) CanvasContext(width, height, dpm float64) dom.CanvasRenderingContext2D {
+	defer e.lock()()
+	if e.Canvas == nil {
+		e.Canvas = new(Canvas)
+	}
+	e.Canvas.Width, e.Canvas.Height = width, height
+	return e.Canvas
+}
+
+func (e *Element) indexOf(c *Element) int {
+	for i, x := range e.children {
+		if x == c {
+			return i
+		}
+	}
+	return -1
+}
+
+func (e *Element) detach() {
+	if e.parent == nil {
+		return
+	}
+	p := e.parent
+	i := p.indexOf(e)
+	p.children = append(p.children[:i], p.children[i+1:]...)
+	e.parent = nil
+}
+
+func (e *Element) AppendChild(n dom.Node) {
+	defer e.lock()()
+	c := mustElement(n)
+	c.detach()
+	c.parent = e
+	e.children = append(e.children, c)
+}
+
+func (e *Element) ReplaceChild(n, old dom.Node) dom.Node {
+	defer e.lock()()
+	return e.replaceChild(n, old)
+}
+
+func (e *Element) replaceChild(n, old dom.Node) dom.Node {
+	c, o := mustElement(n), mustElement(old)
+	i := e.indexOf(o)
+	if i < 0 {
+		panic("ReplaceChild: old is not a child")
+	}
+	c.detach()
+	i = e.indexOf(o) // detaching may have shifted o
+	e.children[i] = c
+	c.parent = e
+	o.parent = nil
+	return old
+}
+
+func (e *Element) ReplaceWith(n dom.Node) {
+	defer e.lock()()
+	if e.parent == nil {
+		panic("ReplaceWith: element has no parent")
+	}
+	e.parent.replaceChild(n, e)
+}
+
+func (e *Element) RemoveChild(n dom.Node) dom.Node {
+	defer e.lock()()
+	c := mustElement(n)
+	if c.parent != e {
+		panic("RemoveChild: not a child")
+	}
+	c.detach()
+	return n
+}
+
+func (e *Element) find(id string) *Element {
+	if e.attrs["id"] == id {
+		return e
+	}
+	for _, c := range e.children {
+		if f := c.find(id); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// HTML serializes the element and its descendants, with attributes
+// in sorted order. It is meant for comparisons in tests.
+func (e *Element) HTML() string {
+	defer e.lock()()
+	var buf bytes.Buffer
+	e.encode(&buf)
+	return buf.String()
+}
+
+func (e *Element) encode(buf *bytes.Buffer) {
+	if e.IsText() {
+		buf.WriteString(template.HTMLEscapeString(e.text))
+		return
+	}
+
+	fmt.Fprintf(buf, "<%s", e.Tag)
+	keys := make([]string, 0, len(e.attrs))
+	for k := range e.attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(buf, " %s=%q", k, e.attrs[k])
+	}
+	buf.WriteString(">")
+	buf.WriteString(string(e.innerHTML))
+	for _, r := range e.rules {
+		buf.WriteString(r + "\n")
+	}
+	for _, c := range e.children {
+		c.encode(buf)
+	}
+	fmt.Fprintf(buf, "</%s>", e.Tag)
+}
+
+// Dispatch fires the event at e. It calls the listeners for ev.Type
+// on e and then on each of its ancestors, until one stops propagation.
+func (e *Element) Dispatch(ev *Event) {
+	if ev.Src == nil {
+		ev.Src = e
+	}
+
+	// collect the listeners first, since handlers will use the DOM
+	unlock := e.lock()
+	var path [][]*listener
+	for el := e; el != nil; el = el.parent {
+		path = append(path, append([]*listener(nil), el.m[ev.Type]...))
+	}
+	unlock()
+
+	for _, ls := range path {
+		for _, l := range ls {
+			l.h(ev)
+		}
+		if ev.Stopped {
+			return
+		}
+	}
+}
+
+// }}}
+
+// Listeners {{{
+
+type listener struct {
+	h dom.EventHandler
+}
+
+// Release is a no-op; there is nothing to free in memory.
+func (l *listener) Release() {}
+
+type listeners struct {
+	mu *sync.Mutex // the document's
+	m  map[dom.EventType][]*listener
+}
+
+func (ls *listeners) AddEventListener(t dom.EventType, h dom.EventHandler) dom.EventListener {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if ls.m == nil {
+		ls.m = make(map[dom.EventType][]*listener)
+	}
+	l := &listener{h: h}
+	ls.m[t] = append(ls.m[t], l)
+	return l
+}
+
+func (ls *listeners) RemoveEventListener(t dom.EventType, l dom.EventListener) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	for i, x := range ls.m[t] {
+		if x == l {
+			ls.m[t] = append(ls.m[t][:i], ls.m[t][i+1:]...)
+			return
+		}
+	}
+}
+
+// Listeners returns the number of listeners for the event type.
+func (ls *listeners) Listeners(t dom.EventType) int {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return len(ls.m[t])
+}
+
+// }}}
+
+// Event {{{
+
+// Event is a synthetic dom.Event. The coordinate methods (OffsetX,
+// PageX, ClientX) all report X and Y.
+type Event struct {
+	Type dom.EventType
+
+	// Src is the target, set by Dispatch if nil.
+	Src *Element
+
+	X, Y         int
+	DX, DY       int // movement
+	Key          int
+	KeyName      string // see Code
+	Stopped      bool
+	Defaulted    bool // see PreventDefault
+	Transfer     dom.DataTransfer
+	NotAnyObject bool // see IsUndefined
+}
+
+func (e *Event) Target() dom.Element {
+	if e.Src == nil {
+		return nil
+	}
+	return e.Src
+}
+
+func (e *Event) OffsetX() int                   { return e.X }
+func (e *Event) OffsetY() int                   { return e.Y }
+func (e *Event) PageX() int                     { return e.X }
+func (e *Event) PageY() int                     { return e.Y }
+func (e *Event) ClientX() int                   { return e.X }
+func (e *Event) ClientY() int                   { return e.Y }
+func (e *Event) MovementX() int                 { return e.DX }
+func (e *Event) MovementY() int                 { return e.DY }
+func (e *Event) Code() string                   { return e.KeyName }
+func (e *Event) KeyCode() int                   { return e.Key }
+func (e *Event) PreventDefault()                { e.Defaulted = true }
+func (e *Event) StopPropagation()               { e.Stopped = true }
+func (e *Event) IsUndefined() bool              { return e.NotAnyObject }
+func (e *Event) DataTransfer() dom.DataTransfer { return e.Transfer }
+
+// }}}
+
+// Canvas {{{
+
+// Canvas is a dom.CanvasRenderingContext2D which records its calls.
+type Canvas struct {
+	Width, Height float64 // as last passed to CanvasContext
+	Calls         []string
+}
+
+func (c *Canvas) record(f string, vs ...interface{}) {
+	c.Calls = append(c.Calls, fmt.Sprintf(f, vs...))
+}
+
+func (c *Canvas) Save()    { c.record("save") }
+func (c *Canvas) Restore() { c.record("restore") }
+func (c *Canvas) Transform(a, b, cc, d, e, f float64) {
+	c.record("transform(%g,%g,%g,%g,%g,%g)", a, b, cc, d, e, f)
+}
+func (c *Canvas) SetFillStyle(s string)        { c.record("fillStyle=%s", s) }
+func (c *Canvas) SetStrokeStyle(s string)      { c.record("strokeStyle=%s", s) }
+func (c *Canvas) SetLineWidth(w float64)       { c.record("lineWidth=%g", w) }
+func (c *Canvas) SetFont(s string)             { c.record("font=%s", s) }
+func (c *Canvas) SetGlobalAlpha(a float64)     { c.record("globalAlpha=%g", a) }
+func (c *Canvas) ClearRect(x, y, w, h float64) { c.record("clearRect(%g,%g,%g,%g)", x, y, w, h) }
+func (c *Canvas) FillRect(x, y, w, h float64)  { c.record("fillRect(%g,%g,%g,%g)", x, y, w, h) }
+func (c *Canvas) StrokeRect(x, y, w, h float64) {
+	c.record("strokeRect(%g,%g,%g,%g)", x, y, w, h)
+}
+func (c *Canvas) BeginPath()          { c.record("beginPath") }
+func (c *Canvas) ClosePath()          { c.record("closePath") }
+func (c *Canvas) MoveTo(x, y float64) { c.record("moveTo(%g,%g)", x, y) }
+func (c *Canvas) LineTo(x, y float64) { c.record("lineTo(%g,%g)", x, y) }
+func (c *Canvas) Arc(x, y, r, start, end float64) {
+	c.record("arc(%g,%g,%g,%g,%g)", x, y, r, start, end)
+}
+func (c *Canvas) Fill()                           { c.record("fill") }
+func (c *Canvas) Stroke()                         { c.record("stroke") }
+func (c *Canvas) FillText(s string, x, y float64) { c.record("fillText(%q,%g,%g)", s, x, y) }
+
+// MeasureText pretends every rune is 6 units wide.
+func (c *Canvas) MeasureText(s string) float64 { return 6 * float64(len([]rune(s))) }
+
+func (c *Canvas) DrawImage(img dom.Element, x, y, w, h float64) {
+	c.record("drawImage(%g,%g,%g,%g)", x, y, w, h)
+}
+
+// }}}
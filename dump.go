@@ -0,0 +1,213 @@
+package browser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Dump (e.g. Dump, DumpDiff) {{{
+//
+// Dump and DumpDiff print trees for debugging, e.g.,
+//
+//	log.Print(view.Dump())
+//	log.Print(browser.DumpDiff(last, view))
+//
+// Unlike the DOM (see dom.Element's LogSelf), they show the handlers, and
+// the style of a node before it is in a stylesheet.
+
+// Dump returns the tree rooted at n, a line for each node, with its
+// children indented below it. An element's line has its tag, id and
+// classes, e.g., div#save.primary, then its other attributes, its style,
+// the number of rules of its variants and breakpoints, its handlers, and
+// whether it has a CanvasDraw, e.g.,
+//
+//	div.card style="padding:4.000000px;" rules=1 on=click
+//		"Save"
+//
+// A text node is a quoted string.
+func (n *Node) Dump() string {
+	var b strings.Builder
+	for _, l := range dumpLines(n, 0, nil) {
+		b.WriteString(l)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// DumpDiff returns the trees side by side, old on the left, a row for
+// each pair of nodes reconcile compares, marked with how mounting new over
+// old changes the node:
+//
+//	' '  unchanged
+//	'~'  mutated: its attributes, style or handlers are set
+//	'!'  replaced: its element is created again, as are its descendants
+//	'+'  inserted
+//	'-'  removed
+//
+// A node is replaced if its type, tag or text differ from the old node's.
+// Lines wider than a column are cut short.
+func DumpDiff(old, new *Node) string {
+	const width = 60
+
+	var b strings.Builder
+	for _, r := range diffRows(old, new, 0) {
+		l := fmt.Sprintf("%c %s | %s", r.mark, pad(clip(r.old, width), width), clip(r.new, width))
+		b.WriteString(strings.TrimRight(l, " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func dumpLines(n *Node, depth int, lines []string) []string {
+	lines = append(lines, strings.Repeat("\t", depth)+describe(n))
+	for _, c := range n.Children {
+		lines = dumpLines(c, depth+1, lines)
+	}
+	return lines
+}
+
+// describe returns the line of n, without its children.
+func describe(n *Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return strconv.Quote(n.Data)
+	case html.ElementNode:
+	default:
+		return fmt.Sprintf("unknown node type %d", n.Type)
+	}
+
+	tag := n.Data
+	if tag == "" {
+		tag = n.DataAtom.String()
+	}
+	var b strings.Builder
+	b.WriteString(tag)
+	if id, ok := n.GetAttr("id"); ok && id != "" {
+		b.WriteString("#" + id)
+	}
+	if class, ok := n.GetAttr("class"); ok {
+		for _, c := range strings.Fields(class) {
+			b.WriteString("." + c)
+		}
+	}
+	for _, a := range n.Attr {
+		if a.Namespace == "" && (a.Key == "id" || a.Key == "class") {
+			continue
+		}
+		key := a.Key
+		if a.Namespace != "" {
+			key = a.Namespace + ":" + key
+		}
+		b.WriteString(" " + key + "=" + strconv.Quote(a.Val))
+	}
+	if val := n.Style.Val(); val != "" {
+		b.WriteString(" style=" + strconv.Quote(val))
+	}
+	if rs := n.Style.rules("&"); len(rs) > 0 {
+		fmt.Fprintf(&b, " rules=%d", len(rs))
+	}
+	if ts := n.Handlers.Types(); len(ts) > 0 {
+		on := make([]string, len(ts))
+		for i, t := range ts {
+			on[i] = string(t)
+		}
+		b.WriteString(" on=" + strings.Join(on, ","))
+	}
+	if n.CanvasDraw != nil {
+		b.WriteString(" canvas")
+	}
+	return b.String()
+}
+
+type diffRow struct {
+	mark     byte
+	old, new string
+}
+
+// diffRows pairs the nodes of the trees as reconcile does: by their
+// position among their parent's children.
+func diffRows(old, new *Node, depth int) (rows []diffRow) {
+	indent := strings.Repeat("  ", depth)
+	switch {
+	case old == nil:
+		for _, l := range dumpLines(new, 0, nil) {
+			rows = append(rows, diffRow{'+', "", indent + l})
+		}
+		return rows
+	case new == nil:
+		for _, l := range dumpLines(old, 0, nil) {
+			rows = append(rows, diffRow{'-', indent + l, ""})
+		}
+		return rows
+	}
+
+	o, n := describe(old), describe(new)
+	if replaced(old, new) {
+		os, ns := dumpLines(old, 0, nil), dumpLines(new, 0, nil)
+		for i := 0; i < len(os) || i < len(ns); i++ {
+			r := diffRow{mark: '!'}
+			if i < len(os) {
+				r.old = indent + os[i]
+			}
+			if i < len(ns) {
+				r.new = indent + ns[i]
+			}
+			rows = append(rows, r)
+		}
+		return rows
+	}
+
+	r := diffRow{' ', indent + o, indent + n}
+	if o != n {
+		r.mark = '~'
+	}
+	rows = append(rows, r)
+	for i := 0; i < len(old.Children) || i < len(new.Children); i++ {
+		var oc, nc *Node
+		if i < len(old.Children) {
+			oc = old.Children[i]
+		}
+		if i < len(new.Children) {
+			nc = new.Children[i]
+		}
+		rows = append(rows, diffRows(oc, nc, depth+1)...)
+	}
+	return rows
+}
+
+// replaced reports whether reconcile replaces old with new, rather than
+// mutating it.
+func replaced(old, new *Node) bool {
+	if old.Type != new.Type {
+		return true
+	}
+	switch old.Type {
+	case html.ElementNode:
+		return old.DataAtom != new.DataAtom || old.Data != new.Data
+	case html.TextNode:
+		return old.Data != new.Data
+	}
+	return false
+}
+
+// clip cuts s short to width runes, marking the cut with "…".
+func clip(s string, width int) string {
+	s = strings.ReplaceAll(s, "\t", "  ")
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-1]) + "…"
+}
+
+func pad(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+// }}}
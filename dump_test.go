@@ -0,0 +1,35 @@
+package browser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nlandolfi/browser/dom"
+)
+
+func TestDump(t *testing.T) {
+	n := MustParseHTML(`<div id="app" class="card wide" title="x"><p style="color:red;">Hi</p></div>`)[0]
+	n.OnClick(func(dom.Event) {})
+
+	want := "div#app.card.wide title=\"x\" on=click\n" +
+		"\tp style=\"color:red;\"\n" +
+		"\t\t\"Hi\"\n"
+	if got := n.Dump(); got != want {
+		t.Errorf("Dump:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestDumpDiff(t *testing.T) {
+	old := MustParseHTML(`<div><p>Hi</p><span>a</span><b>x</b></div>`)[0]
+	new := MustParseHTML(`<div class="on"><p>Hi</p><em>a</em></div>`)[0]
+
+	var marks string
+	for _, l := range strings.Split(strings.TrimSuffix(DumpDiff(old, new), "\n"), "\n") {
+		marks += l[:1]
+	}
+	// the div is mutated, the p kept, the span replaced by the em, and the
+	// b removed
+	if want := "~  !!--"; marks != want {
+		t.Errorf("DumpDiff marks: got %q, want %q\n%s", marks, want, DumpDiff(old, new))
+	}
+}
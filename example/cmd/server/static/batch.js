@@ -0,0 +1,74 @@
+// batch.js installs the interpreter for the Mounter's Batch mode as
+// `browserBatch`. See batch.go in github.com/nlandolfi/browser for the ops.
+(() => {
+	const nodes = new Map();     // id -> DOM node
+	const listeners = new Map(); // id -> {type: function}
+	let handler = () => {};
+
+	const lookup = (root, id) => id === 0 ? root : nodes.get(id);
+
+	window.browserBatch = {
+		apply(root, encoded) {
+			for (const op of JSON.parse(encoded)) {
+				switch (op[0]) {
+				case "c":
+					nodes.set(op[1], document.createElement(op[2]));
+					break;
+				case "t":
+					nodes.set(op[1], document.createTextNode(op[2]));
+					break;
+				case "a":
+					lookup(root, op[1]).appendChild(lookup(root, op[2]));
+					break;
+				case "r":
+					lookup(root, op[1]).replaceChild(lookup(root, op[2]), lookup(root, op[3]));
+					break;
+				case "x":
+					lookup(root, op[1]).removeChild(lookup(root, op[2]));
+					break;
+				case "s": {
+					const el = lookup(root, op[1]);
+					if (op[2] === "value") {
+						el.value = op[3];
+					}
+					el.setAttribute(op[2], op[3]);
+					break;
+				}
+				case "d":
+					lookup(root, op[1]).removeAttribute(op[2]);
+					break;
+				case "l": {
+					const id = op[1], type = op[2];
+					const fn = (e) => handler(id, type, e);
+					if (!listeners.has(id)) {
+						listeners.set(id, {});
+					}
+					listeners.get(id)[type] = fn;
+					lookup(root, id).addEventListener(type, fn);
+					break;
+				}
+				case "u": {
+					const ls = listeners.get(op[1]);
+					if (ls && ls[op[2]]) {
+						lookup(root, op[1]).removeEventListener(op[2], ls[op[2]]);
+						delete ls[op[2]];
+					}
+					break;
+				}
+				case "f":
+					nodes.delete(op[1]);
+					listeners.delete(op[1]);
+					break;
+				default:
+					throw new Error("browserBatch: unknown op " + op[0]);
+				}
+			}
+		},
+		element(id) {
+			return nodes.get(id) || null;
+		},
+		listen(fn) {
+			handler = fn;
+		},
+	};
+})();
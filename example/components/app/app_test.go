@@ -0,0 +1,14 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser/browsertest"
+	"github.com/nlandolfi/browser/ui"
+)
+
+func TestView(t *testing.T) {
+	view := View(&State{Theme: ui.DefaultTheme})
+	browsertest.Golden(t, "view", view)
+	browsertest.Accessible(t, view)
+}
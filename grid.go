@@ -0,0 +1,82 @@
+package browser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Grid {{{
+//
+// The grid values are strings built by the functions here, e.g.,
+//
+//	browser.Style{
+//		Display:             browser.DisplayGrid,
+//		GridTemplateColumns: browser.Tracks(browser.RepeatFill(browser.MinMax(browser.PX(200), browser.FR(1)))),
+//		GridTemplateAreas:   browser.Areas("header header", "nav main"),
+//	}
+
+// FR is a fraction of the free space of a grid container, for track sizes.
+func FR(v float64) Size { return Size{Value: v, Unit: UnitFR} }
+
+// A TrackList is the sizes of the rows or columns of a grid,
+// e.g., "200px 1fr".
+type TrackList string
+
+// Tracks returns the track list of the sizes.
+func Tracks(ss ...Size) TrackList { return TrackList(tracks(ss)) }
+
+// MinMax is a track size between min and max.
+func MinMax(min, max Size) Size {
+	return Size{StringOverride: "minmax(" + min.value() + ", " + max.value() + ")"}
+}
+
+// Repeat is n repetitions of the tracks.
+func Repeat(n int, ss ...Size) Size { return repeat(strconv.Itoa(n), ss) }
+
+// RepeatFill repeats the tracks as many times as fit the container.
+func RepeatFill(ss ...Size) Size { return repeat("auto-fill", ss) }
+
+// RepeatFit repeats the tracks as RepeatFill does, then collapses the
+// empty repetitions.
+func RepeatFit(ss ...Size) Size { return repeat("auto-fit", ss) }
+
+func repeat(count string, ss []Size) Size {
+	return Size{StringOverride: "repeat(" + count + ", " + tracks(ss) + ")"}
+}
+
+func tracks(ss []Size) string {
+	ts := make([]string, len(ss))
+	for i, s := range ss {
+		ts[i] = s.value()
+	}
+	return strings.Join(ts, " ")
+}
+
+// GridAreas names the cells of a grid, by rows, e.g., `"header header" "nav main"`.
+type GridAreas string
+
+// Areas returns the grid areas of the rows, each a space separated list
+// of cell names, with "." for an unnamed cell.
+func Areas(rows ...string) GridAreas {
+	qs := make([]string, len(rows))
+	for i, r := range rows {
+		qs[i] = `"` + cssEscaper.Replace(r) + `"`
+	}
+	return GridAreas(strings.Join(qs, " "))
+}
+
+// cssEscaper escapes the text of a CSS string, between double quotes.
+var cssEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// A GridLine places an item between lines of a grid, e.g., "1 / 3" or "span 2".
+type GridLine string
+
+// Lines places an item from the start line to the end line, counting from 1.
+func Lines(start, end int) GridLine {
+	return GridLine(strconv.Itoa(start) + " / " + strconv.Itoa(end))
+}
+
+// Span places an item across n tracks.
+func Span(n int) GridLine { return GridLine("span " + strconv.Itoa(n)) }
+
+// }}}
@@ -0,0 +1,78 @@
+package browser
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ParseHTML parses an HTML fragment, as the content of a body element,
+// into Nodes the Mounter can reconcile, e.g., the output of an
+// html/template.
+//
+// Elements and text are kept; comments are dropped. A known element has
+// only its DataAtom, as the builders in package ui set it, so that it
+// reconciles with them. The style attribute becomes the Style, if
+// ParseStyle recognizes each of its declarations; otherwise it is kept
+// as an attribute, and the Style is left zero.
+func ParseHTML(r io.Reader) ([]*Node, error) {
+	body := &html.Node{Type: html.ElementNode, DataAtom: atom.Body, Data: "body"}
+	hs, err := html.ParseFragment(r, body)
+	if err != nil {
+		return nil, fmt.Errorf("browser.ParseHTML: %v", err)
+	}
+
+	return fromHTML(hs), nil
+}
+
+// MustParseHTML is ParseHTML for static chunks of HTML, it panics if h
+// can't be parsed.
+func MustParseHTML(h template.HTML) []*Node {
+	ns, err := ParseHTML(strings.NewReader(string(h)))
+	if err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+func fromHTML(hs []*html.Node) []*Node {
+	var ns []*Node
+	for _, h := range hs {
+		switch h.Type {
+		case html.TextNode:
+			ns = append(ns, &Node{Type: html.TextNode, Data: h.Data})
+		case html.ElementNode:
+			ns = append(ns, fromElement(h))
+		}
+	}
+	return ns
+}
+
+func fromElement(h *html.Node) *Node {
+	n := &Node{Type: html.ElementNode, DataAtom: h.DataAtom}
+	if h.DataAtom == 0 {
+		n.Data = h.Data
+	}
+
+	for _, a := range h.Attr {
+		if a.Namespace == "" && a.Key == "style" {
+			if s, unrecognized := ParseStyle(a.Val); len(unrecognized) == 0 {
+				n.Style = s
+				continue
+			}
+		}
+		n.Attr = append(n.Attr, &html.Attribute{Namespace: a.Namespace, Key: a.Key, Val: a.Val})
+	}
+
+	var children []*html.Node
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		children = append(children, c)
+	}
+	n.Children = fromHTML(children)
+
+	return n
+}
@@ -0,0 +1,58 @@
+package browser
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestParseHTML(t *testing.T) {
+	ns := MustParseHTML(`<p class="lead" style="color:red;padding:4px">Hi, <b>there</b><!-- x --></p>` +
+		`<my-el style="color:red;-webkit-line-clamp:2"></my-el>`)
+	if len(ns) != 2 {
+		t.Fatalf("got %d nodes, want 2", len(ns))
+	}
+
+	p, el := ns[0], ns[1]
+	if p.DataAtom != atom.P || p.Data != "" {
+		t.Errorf("p: got atom %v, data %q", p.DataAtom, p.Data)
+	}
+	if got := p.Style.Val(); got != "color:red;padding:4.000000px;" {
+		t.Errorf("p style: got %s", got)
+	}
+	if len(p.Attr) != 1 || p.Attr[0].Key != "class" {
+		t.Errorf("p attrs: got %v, want the class", p.Attr)
+	}
+	if len(p.Children) != 2 || p.Children[0].Data != "Hi, " || p.Children[1].DataAtom != atom.B {
+		t.Errorf("p children: got %v", p.Children)
+	}
+	if el.DataAtom != 0 || el.Data != "my-el" {
+		t.Errorf("custom element: got atom %v, data %q", el.DataAtom, el.Data)
+	}
+	if !el.Style.Equal(&Style{}) || len(el.Attr) != 1 || el.Attr[0].Key != "style" {
+		t.Errorf("partly recognized style: got %q and %v, want the attribute kept", el.Style.Val(), el.Attr)
+	}
+
+	// parsed nodes reconcile with built ones
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+	wrap := func(ns ...*Node) *Node {
+		return &Node{Type: html.ElementNode, DataAtom: atom.Div, Children: ns}
+	}
+	if err := m.Mount(wrap(MustParseHTML(`<b>bold</b>`)...)); err != nil {
+		t.Fatal(err)
+	}
+	b := d.BodyElement().Children()[0].Children()[0]
+	built := &Node{Type: html.ElementNode, DataAtom: atom.B, Children: []*Node{{Type: html.TextNode, Data: "bolder"}}}
+	if err := m.Mount(wrap(built)); err != nil {
+		t.Fatal(err)
+	}
+	if d.BodyElement().Children()[0].Children()[0] != b {
+		t.Error("the parsed element was replaced, want it reconciled")
+	}
+	if got, want := d.BodyElement().HTML(), `<body><div style=""><b style="">bolder</b></div></body>`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
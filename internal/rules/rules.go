@@ -0,0 +1,7 @@
+// Package rules lets the browsertest package format the rules the
+// Mounter writes for a style, which aren't part of the browser API.
+package rules
+
+// Of returns the rules of the variants and breakpoints of style, a
+// *browser.Style, for the selector. It is set by the browser package.
+var Of func(style interface{}, selector string) []string
@@ -0,0 +1,345 @@
+// Command stylegen generates browser.Style, its enums, its Val and Equal
+// methods, the parsing of its properties, and the Node builders for its
+// properties and variants, from the tables in props.go.
+//
+// Run it with go generate in the browser package:
+//
+//	//go:generate go run ./internal/stylegen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	out := flag.String("o", "style_gen.go", "output file")
+	flag.Parse()
+
+	src, err := generate()
+	if err != nil {
+		log.Fatalf("stylegen: %v", err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("stylegen: %v", err)
+	}
+}
+
+// A composite is a hand-written property type.
+type composite struct {
+	IsSet string // condition for the property being set
+	Val   string // expression Val prints
+	Parse string // func parsing a value, "" if the value is converted as is
+}
+
+var composites = map[string]composite{
+	"string":    {`s.%s != ""`, `s.%s`, ""},
+	"Size":      {`!s.%s.IsZero()`, `&s.%s`, "parseSize"},
+	"Border":    {`s.%s.Type != BorderUnset`, `&s.%s`, "parseBorder"},
+	"BoxShadow": {`!s.%s.IsZero()`, `&s.%s`, "parseBoxShadow"},
+	"Outline":   {`!s.%s.IsZero()`, `&s.%s`, "parseOutline"},
+	"TrackList": {`s.%s != ""`, `s.%s`, ""},
+	"GridAreas": {`s.%s != ""`, `s.%s`, ""},
+	"GridLine":  {`s.%s != ""`, `s.%s`, ""},
+	"Transform": {`s.%s != ""`, `s.%s`, ""},
+
+	"TransitionList": {`s.%s != ""`, `s.%s`, ""},
+	"Animation":      {`!s.%s.IsZero()`, `&s.%s`, "parseAnimation"},
+}
+
+// A field is a property, resolved against the enums and composites.
+type field struct {
+	property
+	IsSet, Val, Parse string
+	Overrides         string // condition for t, merged into s, setting the property
+	Enum              *enum
+}
+
+func generate() ([]byte, error) {
+	byType := make(map[string]*enum)
+	for i := range enums {
+		byType[enums[i].Type] = &enums[i]
+	}
+
+	var (
+		fields  []field
+		methods = make(map[string]bool)
+	)
+	method := func(name string) error {
+		if methods[name] {
+			return fmt.Errorf("duplicate builder %s", name)
+		}
+		methods[name] = true
+		return nil
+	}
+	for _, p := range properties {
+		f := field{property: p}
+		if c, ok := composites[p.Type]; ok {
+			f.IsSet, f.Val, f.Parse = fmt.Sprintf(c.IsSet, p.Field), fmt.Sprintf(c.Val, p.Field), c.Parse
+			f.Overrides = fmt.Sprintf(strings.Replace(c.IsSet, "s.%s", "t.%s", 1), p.Field)
+		} else if e, ok := byType[p.Type]; ok {
+			f.IsSet, f.Val, f.Enum = fmt.Sprintf("s.%s != %sUnset", p.Field, e.Prefix), "s."+p.Field, e
+			f.Overrides = fmt.Sprintf("t.%s != %sUnset", p.Field, e.Prefix)
+			f.Parse = "parse" + e.Type
+		} else {
+			return nil, fmt.Errorf("%s: unknown type %s", p.Field, p.Type)
+		}
+
+		if err := method(p.Field); err != nil {
+			return nil, err
+		}
+		if p.Type == "Size" {
+			for _, u := range units {
+				if err := method(p.Field + u); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if p.IsColor {
+			if p.Type != "string" {
+				return nil, fmt.Errorf("%s: a color must be a string", p.Field)
+			}
+			if err := method(p.Field + "Of"); err != nil {
+				return nil, err
+			}
+		}
+		if f.Enum != nil {
+			for _, v := range f.Enum.Values {
+				if err := method(p.Field + v[0]); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		fields = append(fields, f)
+	}
+	for _, v := range variants {
+		if err := method(v.Field + "Style"); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Enums    []enum
+		Fields   []field
+		Variants []variant
+		Units    []string
+	}{enums, fields, variants, units}); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting: %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+var tmpl = template.Must(template.New("style").Parse(`// Code generated by internal/stylegen; DO NOT EDIT.
+
+package browser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Enums {{"{{{"}}
+{{range .Enums}}{{$e := .}}
+type {{.Type}} int
+
+const (
+	{{.Prefix}}Unset {{.Type}} = iota
+{{- range .Values}}
+	{{$e.Prefix}}{{index . 0}}
+{{- end}}
+)
+
+func (t {{.Type}}) String() string {
+	switch t {
+	case {{.Prefix}}Unset:
+		return ""
+{{- range .Values}}
+	case {{$e.Prefix}}{{index . 0}}:
+		return "{{index . 1}}"
+{{- end}}
+	}
+
+	panic(fmt.Sprintf("unknown {{.Type}}: %#v", t))
+}
+
+func parse{{.Type}}(s string) ({{.Type}}, bool) {
+	switch s {
+{{- range .Values}}
+	case "{{index . 1}}":
+		return {{$e.Prefix}}{{index . 0}}, true
+{{- end}}
+	}
+
+	return {{.Prefix}}Unset, false
+}
+{{end}}
+// }}}
+
+// Style {{"{{{"}}
+
+// Style is the inline style of a Node. Each field is a CSS property;
+// the zero value of a field leaves the property unset.
+//
+// As Vars and Breakpoints are a map and a slice, Style is not comparable
+// with ==; use Equal.
+type Style struct {
+{{- range .Fields}}
+	{{.Field}} {{.Type}}
+{{- end}}
+
+	// Vars are the custom properties, keyed by name (e.g., "--accent").
+	// Set them with Node.SetVar, which copies the map before writing.
+	Vars map[string]string
+
+	// The variants are styles for a state of the element, which inline
+	// styles can't express. The Mounter writes them as rules for a
+	// generated class, see stylesheet.go.
+{{- range .Variants}}
+	{{.Field}} *Style
+{{- end}}
+
+	// Breakpoints are styles for wide viewports, which the Mounter also
+	// writes as rules; set them with Node.Breakpoint.
+	Breakpoints []Breakpoint
+}
+
+// Val encodes the set properties as the value of a style attribute.
+func (s *Style) Val() string {
+	if s == nil {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	w := &buf
+
+	s.encodeVars(w)
+{{range .Fields}}
+	if {{.IsSet}} {
+		fmt.Fprintf(w, "{{.CSS}}:%s;", {{.Val}})
+{{- $f := .}}{{range .Prefixes}}
+		fmt.Fprintf(w, "{{.}}{{$f.CSS}}:%s;", {{$f.Val}})
+{{- end}}
+	}
+{{end}}
+	return buf.String()
+}
+
+// Equal reports whether s and t set the same properties to the same values.
+func (s *Style) Equal(t *Style) bool {
+	if s == nil || t == nil {
+		return s == t
+	}
+
+	return {{range .Fields}}s.{{.Field}} == t.{{.Field}} &&
+		{{end}}varsEqual(s.Vars, t.Vars){{range .Variants}} &&
+		s.{{.Field}}.Equal(t.{{.Field}}){{end}} &&
+		breakpointsEqual(s.Breakpoints, t.Breakpoints)
+}
+
+// Merge overlays t on s: each property t sets, and each of its custom
+// properties, overrides s's, while those t leaves unset keep s's value.
+// A variant set in both is merged in the same way, and t's breakpoints
+// follow s's, so that they win at the same width. A shorthand t sets
+// (e.g., Padding) doesn't unset the longhands s sets (e.g., PaddingLeft).
+// Merge doesn't write to the maps, slices or variants s may share.
+func (s *Style) Merge(t Style) {
+{{- range .Fields}}
+	if {{.Overrides}} {
+		s.{{.Field}} = t.{{.Field}}
+	}
+{{- end}}
+
+	s.Vars = mergeVars(s.Vars, t.Vars)
+{{- range .Variants}}
+	s.{{.Field}} = mergeVariant(s.{{.Field}}, t.{{.Field}})
+{{- end}}
+	s.Breakpoints = mergeBreakpoints(s.Breakpoints, t.Breakpoints)
+}
+
+// variants returns the variants which are set.
+func (s *Style) variants() (vs []variant) {
+{{- range .Variants}}
+	if s.{{.Field}} != nil {
+		vs = append(vs, variant{"{{.Selector}}", s.{{.Field}}})
+	}
+{{- end}}
+	return vs
+}
+
+// setVariant sets the variant for the selector, reporting whether the
+// selector is one.
+func (s *Style) setVariant(selector string, v *Style) bool {
+	switch selector {
+{{- range .Variants}}
+	case "{{.Selector}}":
+		s.{{.Field}} = v
+{{- end}}
+	default:
+		return false
+	}
+	return true
+}
+
+// parseProperty sets the property, reporting whether the name and value
+// were recognized. The name is lower case, and the value trimmed.
+func (s *Style) parseProperty(name, value string) bool {
+	switch name {
+{{- range .Fields}}
+	case "{{.CSS}}"{{$f := .}}{{range .Prefixes}}, "{{.}}{{$f.CSS}}"{{end}}:
+{{- if .Parse}}
+{{- if .Enum}}
+		v, ok := {{.Parse}}(strings.ToLower(value))
+{{- else}}
+		v, ok := {{.Parse}}(value)
+{{- end}}
+		if ok {
+			s.{{.Field}} = v
+		}
+		return ok
+{{- else if eq .Type "string"}}
+		s.{{.Field}} = value
+		return true
+{{- else}}
+		s.{{.Field}} = {{.Type}}(value)
+		return true
+{{- end}}
+{{- end}}
+	}
+
+	return false
+}
+
+// }}}
+
+// Style Builders (e.g., Width, WidthPX, DisplayFlex) {{"{{{"}}
+{{range .Fields}}{{$f := .}}
+func (n *Node) {{.Field}}(v {{.Type}}) *Node { n.Style.{{.Field}} = v; return n }
+{{- if eq .Type "Size"}}{{range $.Units}}
+func (n *Node) {{$f.Field}}{{.}}(v float64) *Node { return n.{{$f.Field}}(Size{Value: v, Unit: Unit{{.}}}) }
+{{- end}}{{end}}
+{{- with .Enum}}{{range .Values}}
+func (n *Node) {{$f.Field}}{{index . 0}}() *Node { return n.{{$f.Field}}({{$f.Enum.Prefix}}{{index . 0}}) }
+{{- end}}{{end}}
+{{- if .IsColor}}
+func (n *Node) {{.Field}}Of(c Color) *Node { return n.{{.Field}}(c.String()) }
+{{- end}}
+{{end}}
+{{- range .Variants}}
+func (n *Node) {{.Field}}Style(s Style) *Node { n.Style.{{.Field}} = &s; return n }
+{{- end}}
+
+// }}}
+`))
@@ -0,0 +1,336 @@
+package main
+
+// An enum is a typed set of CSS keywords. Its zero value, <Prefix>Unset,
+// encodes as "" and leaves the property unset.
+type enum struct {
+	Type   string      // e.g., DisplayType
+	Prefix string      // of the constants, e.g., Display
+	Values [][2]string // constant suffix and keyword, in constant order
+}
+
+// A property is a field of Style.
+type property struct {
+	Field string // also the name of its builder
+	CSS   string
+	Type  string // string, Size, or another composite in main.go, or an enum's Type
+
+	// Prefixes are the vendor prefixes Val also writes the property with.
+	Prefixes []string
+
+	// IsColor is set for a string property holding a color, which also
+	// has a builder <Field>Of, taking a browser.Color.
+	IsColor bool
+}
+
+// A variant is a Style for a state of an element, e.g., :hover, which
+// the Mounter compiles into a rule of its managed stylesheet.
+type variant struct {
+	Field    string // of Style; its builder is <Field>Style
+	Selector string // of the state, appended to the generated class
+}
+
+// New values go at the end of an enum, so the constants keep their values.
+var enums = []enum{
+	{"AlignItemsType", "AlignItems", [][2]string{
+		{"Center", "center"},
+		{"FlexStart", "flex-start"},
+		{"FlexEnd", "flex-end"},
+		{"Stretch", "stretch"},
+		{"Baseline", "baseline"},
+		{"Start", "start"},
+		{"End", "end"},
+	}},
+	{"AlignSelfType", "AlignSelf", [][2]string{
+		{"Auto", "auto"},
+		{"Center", "center"},
+		{"FlexStart", "flex-start"},
+		{"FlexEnd", "flex-end"},
+		{"Stretch", "stretch"},
+		{"Baseline", "baseline"},
+		{"Start", "start"},
+		{"End", "end"},
+	}},
+	{"AnimationDirectionType", "AnimationDirection", [][2]string{
+		{"Normal", "normal"},
+		{"Reverse", "reverse"},
+		{"Alternate", "alternate"},
+		{"AlternateReverse", "alternate-reverse"},
+	}},
+	{"AnimationFillModeType", "AnimationFillMode", [][2]string{
+		{"None", "none"},
+		{"Forwards", "forwards"},
+		{"Backwards", "backwards"},
+		{"Both", "both"},
+	}},
+	{"BorderType", "Border", [][2]string{
+		{"None", "none"},
+		{"Solid", "solid"},
+		{"Dashed", "dashed"},
+		{"Dotted", "dotted"},
+		{"Double", "double"},
+	}},
+	{"BoxSizingType", "BoxSizing", [][2]string{
+		{"BorderBox", "border-box"},
+		{"ContentBox", "content-box"},
+	}},
+	{"CursorType", "Cursor", [][2]string{
+		{"Default", "default"},
+		{"Pointer", "pointer"},
+		{"Move", "move"},
+		{"NWSEResize", "nwse-resize"},
+		{"EWResize", "ew-resize"},
+		{"NSResize", "ns-resize"},
+		{"Text", "text"},
+		{"NotAllowed", "not-allowed"},
+		{"Grab", "grab"},
+		{"Grabbing", "grabbing"},
+		{"Crosshair", "crosshair"},
+		{"Wait", "wait"},
+	}},
+	{"DisplayType", "Display", [][2]string{
+		{"None", "none"},
+		{"Flex", "flex"},
+		{"Grid", "grid"},
+		{"Block", "block"},
+		{"Inline", "inline"},
+		{"InlineBlock", "inline-block"},
+		{"InlineFlex", "inline-flex"},
+		{"InlineGrid", "inline-grid"},
+		{"Contents", "contents"},
+	}},
+	{"FlexDirectionType", "FlexDirection", [][2]string{
+		{"Row", "row"},
+		{"Column", "column"},
+		{"RowReverse", "row-reverse"},
+		{"ColumnReverse", "column-reverse"},
+	}},
+	{"FlexWrapType", "FlexWrap", [][2]string{
+		{"Wrap", "wrap"},
+		{"NoWrap", "nowrap"},
+		{"WrapReverse", "wrap-reverse"},
+	}},
+	{"FontStyleType", "FontStyle", [][2]string{
+		{"Normal", "normal"},
+		{"Italic", "italic"},
+		{"Oblique", "oblique"},
+	}},
+	{"GridAutoFlowType", "GridAutoFlow", [][2]string{
+		{"Row", "row"},
+		{"Column", "column"},
+		{"Dense", "dense"},
+		{"RowDense", "row dense"},
+		{"ColumnDense", "column dense"},
+	}},
+	{"JustifyContentType", "JustifyContent", [][2]string{
+		{"Center", "center"},
+		{"SpaceBetween", "space-between"},
+		{"FlexEnd", "flex-end"},
+		{"FlexStart", "flex-start"},
+		{"SpaceAround", "space-around"},
+		{"SpaceEvenly", "space-evenly"},
+		{"Start", "start"},
+		{"End", "end"},
+	}},
+	{"JustifyItemsType", "JustifyItems", [][2]string{
+		{"Start", "start"},
+		{"End", "end"},
+		{"Center", "center"},
+		{"Stretch", "stretch"},
+		{"Baseline", "baseline"},
+	}},
+	{"JustifySelfType", "JustifySelf", [][2]string{
+		{"Right", "right"},
+		{"Left", "left"},
+		{"FlexEnd", "flex-end"},
+		{"FlexStart", "flex-start"},
+		{"Center", "center"},
+		{"Start", "start"},
+		{"End", "end"},
+		{"Stretch", "stretch"},
+	}},
+	{"ObjectFitType", "ObjectFit", [][2]string{
+		{"Fill", "fill"},
+		{"Contain", "contain"},
+		{"Cover", "cover"},
+		{"None", "none"},
+		{"ScaleDown", "scale-down"},
+	}},
+	{"OutlineType", "Outline", [][2]string{
+		{"None", "none"},
+		{"Solid", "solid"},
+		{"Dashed", "dashed"},
+		{"Dotted", "dotted"},
+	}},
+	{"OverflowType", "Overflow", [][2]string{
+		{"Hidden", "hidden"},
+		{"Scroll", "scroll"},
+		{"Auto", "auto"},
+		{"Visible", "visible"},
+	}},
+	{"PointerEventsType", "PointerEvents", [][2]string{
+		{"Auto", "auto"},
+		{"None", "none"},
+	}},
+	{"PositionType", "Position", [][2]string{
+		{"Relative", "relative"},
+		{"Absolute", "absolute"},
+		{"Fixed", "fixed"},
+		{"Sticky", "sticky"},
+		{"Static", "static"},
+	}},
+	{"TextAlignType", "TextAlign", [][2]string{
+		{"Center", "center"},
+		{"Right", "right"},
+		{"Left", "left"},
+		{"Justify", "justify"},
+	}},
+	{"TextDecorationType", "TextDecoration", [][2]string{
+		{"LineThrough", "line-through"},
+		{"Overline", "overline"},
+		{"Underline", "underline"},
+		{"None", "none"},
+	}},
+	{"TextOverflowType", "TextOverflow", [][2]string{
+		{"Clip", "clip"},
+		{"Ellipsis", "ellipsis"},
+	}},
+	{"TextTransformType", "TextTransform", [][2]string{
+		{"None", "none"},
+		{"Uppercase", "uppercase"},
+		{"Lowercase", "lowercase"},
+		{"Capitalize", "capitalize"},
+	}},
+	{"VerticalAlignType", "VerticalAlign", [][2]string{
+		{"Baseline", "baseline"},
+		{"Top", "top"},
+		{"Middle", "middle"},
+		{"Bottom", "bottom"},
+		{"TextTop", "text-top"},
+		{"TextBottom", "text-bottom"},
+	}},
+	{"VisibilityType", "Visibility", [][2]string{
+		{"Visible", "visible"},
+		{"Hidden", "hidden"},
+		{"Collapse", "collapse"},
+	}},
+	{"WhiteSpaceType", "WhiteSpace", [][2]string{
+		{"Normal", "normal"},
+		{"NoWrap", "nowrap"},
+		{"Pre", "pre"},
+		{"PreWrap", "pre-wrap"},
+		{"PreLine", "pre-line"},
+	}},
+	{"WordBreakType", "WordBreak", [][2]string{
+		{"Normal", "normal"},
+		{"BreakAll", "break-all"},
+		{"KeepAll", "keep-all"},
+		{"BreakWord", "break-word"},
+	}},
+}
+
+// Val writes the properties in this order, so a shorthand must come
+// before its longhands (e.g., gap before column-gap), or it would
+// override them.
+var properties = []property{
+	{Field: "AlignItems", CSS: "align-items", Type: "AlignItemsType"},
+	{Field: "AlignSelf", CSS: "align-self", Type: "AlignSelfType"},
+	{Field: "Animation", CSS: "animation", Type: "Animation"},
+	{Field: "Background", CSS: "background", Type: "string"},
+	{Field: "BackgroundColor", CSS: "background-color", Type: "string", IsColor: true},
+	{Field: "BackgroundImage", CSS: "background-image", Type: "string"},
+	{Field: "BackgroundPosition", CSS: "background-position", Type: "string"},
+	{Field: "BackgroundRepeat", CSS: "background-repeat", Type: "string"},
+	{Field: "BackgroundSize", CSS: "background-size", Type: "string"},
+	{Field: "Border", CSS: "border", Type: "Border"},
+	{Field: "BorderBottom", CSS: "border-bottom", Type: "Border"},
+	{Field: "BorderLeft", CSS: "border-left", Type: "Border"},
+	{Field: "BorderRight", CSS: "border-right", Type: "Border"},
+	{Field: "BorderTop", CSS: "border-top", Type: "Border"},
+	{Field: "BorderColor", CSS: "border-color", Type: "string", IsColor: true}, // after the sides, to override their colors
+	{Field: "BorderRadius", CSS: "border-radius", Type: "Size"},
+	{Field: "Bottom", CSS: "bottom", Type: "Size"},
+	{Field: "BoxShadow", CSS: "box-shadow", Type: "BoxShadow"},
+	{Field: "BoxSizing", CSS: "box-sizing", Type: "BoxSizingType"},
+	{Field: "Color", CSS: "color", Type: "string", IsColor: true},
+	{Field: "Cursor", CSS: "cursor", Type: "CursorType"},
+	{Field: "Display", CSS: "display", Type: "DisplayType"},
+	{Field: "FlexBasis", CSS: "flex-basis", Type: "string"},
+	{Field: "FlexDirection", CSS: "flex-direction", Type: "FlexDirectionType"},
+	{Field: "FlexGrow", CSS: "flex-grow", Type: "string"},
+	{Field: "FlexShrink", CSS: "flex-shrink", Type: "string"},
+	{Field: "FlexWrap", CSS: "flex-wrap", Type: "FlexWrapType"},
+	{Field: "FontFamily", CSS: "font-family", Type: "string"},
+	{Field: "FontSize", CSS: "font-size", Type: "Size"},
+	{Field: "FontStyle", CSS: "font-style", Type: "FontStyleType"},
+	{Field: "FontWeight", CSS: "font-weight", Type: "string"},
+	{Field: "Gap", CSS: "gap", Type: "Size"},
+	{Field: "ColumnGap", CSS: "column-gap", Type: "Size"},
+	{Field: "RowGap", CSS: "row-gap", Type: "Size"},
+	{Field: "GridArea", CSS: "grid-area", Type: "string"}, // before the longhands, grid-row and grid-column
+	{Field: "GridAutoColumns", CSS: "grid-auto-columns", Type: "TrackList"},
+	{Field: "GridAutoFlow", CSS: "grid-auto-flow", Type: "GridAutoFlowType"},
+	{Field: "GridAutoRows", CSS: "grid-auto-rows", Type: "TrackList"},
+	{Field: "GridColumn", CSS: "grid-column", Type: "GridLine"},
+	{Field: "GridRow", CSS: "grid-row", Type: "GridLine"},
+	{Field: "GridTemplateAreas", CSS: "grid-template-areas", Type: "GridAreas"},
+	{Field: "GridTemplateColumns", CSS: "grid-template-columns", Type: "TrackList"},
+	{Field: "GridTemplateRows", CSS: "grid-template-rows", Type: "TrackList"},
+	{Field: "Height", CSS: "height", Type: "Size"},
+	{Field: "JustifyContent", CSS: "justify-content", Type: "JustifyContentType"},
+	{Field: "JustifyItems", CSS: "justify-items", Type: "JustifyItemsType"},
+	{Field: "JustifySelf", CSS: "justify-self", Type: "JustifySelfType"},
+	{Field: "Left", CSS: "left", Type: "Size"},
+	{Field: "LetterSpacing", CSS: "letter-spacing", Type: "Size"},
+	{Field: "LineHeight", CSS: "line-height", Type: "string"}, // often unitless
+	{Field: "Margin", CSS: "margin", Type: "Size"},
+	{Field: "MarginBottom", CSS: "margin-bottom", Type: "Size"},
+	{Field: "MarginLeft", CSS: "margin-left", Type: "Size"},
+	{Field: "MarginRight", CSS: "margin-right", Type: "Size"},
+	{Field: "MarginTop", CSS: "margin-top", Type: "Size"},
+	{Field: "MaxHeight", CSS: "max-height", Type: "Size"},
+	{Field: "MaxWidth", CSS: "max-width", Type: "Size"},
+	{Field: "MinHeight", CSS: "min-height", Type: "Size"},
+	{Field: "MinWidth", CSS: "min-width", Type: "Size"},
+	{Field: "ObjectFit", CSS: "object-fit", Type: "ObjectFitType"},
+	{Field: "Opacity", CSS: "opacity", Type: "string"},
+	{Field: "Order", CSS: "order", Type: "string"},
+	{Field: "Outline", CSS: "outline", Type: "Outline"},
+	{Field: "Overflow", CSS: "overflow", Type: "OverflowType"},
+	{Field: "OverflowX", CSS: "overflow-x", Type: "OverflowType"},
+	{Field: "OverflowY", CSS: "overflow-y", Type: "OverflowType"},
+	{Field: "Padding", CSS: "padding", Type: "Size"},
+	{Field: "PaddingBottom", CSS: "padding-bottom", Type: "Size"},
+	{Field: "PaddingLeft", CSS: "padding-left", Type: "Size"},
+	{Field: "PaddingRight", CSS: "padding-right", Type: "Size"},
+	{Field: "PaddingTop", CSS: "padding-top", Type: "Size"},
+	{Field: "PointerEvents", CSS: "pointer-events", Type: "PointerEventsType"},
+	{Field: "Position", CSS: "position", Type: "PositionType"},
+	{Field: "Right", CSS: "right", Type: "Size"},
+	{Field: "TextAlign", CSS: "text-align", Type: "TextAlignType"},
+	{Field: "TextDecoration", CSS: "text-decoration", Type: "TextDecorationType"},
+	{Field: "TextOverflow", CSS: "text-overflow", Type: "TextOverflowType"},
+	{Field: "TextTransform", CSS: "text-transform", Type: "TextTransformType"},
+	{Field: "Top", CSS: "top", Type: "Size"},
+	{Field: "Transform", CSS: "transform", Type: "Transform"},
+	{Field: "Transition", CSS: "transition", Type: "TransitionList"},
+	{Field: "UserSelect", CSS: "user-select", Type: "string", Prefixes: []string{"-webkit-", "-moz-", "-ms-"}},
+	{Field: "VerticalAlign", CSS: "vertical-align", Type: "VerticalAlignType"},
+	{Field: "Visibility", CSS: "visibility", Type: "VisibilityType"},
+	{Field: "WhiteSpace", CSS: "white-space", Type: "WhiteSpaceType"},
+	{Field: "Width", CSS: "width", Type: "Size"},
+	{Field: "WordBreak", CSS: "word-break", Type: "WordBreakType"},
+	{Field: "ZIndex", CSS: "z-index", Type: "string"},
+}
+
+var variants = []variant{
+	{"Hover", ":hover"},
+	{"Focus", ":focus"},
+	{"FocusVisible", ":focus-visible"},
+	{"FocusWithin", ":focus-within"},
+	{"Active", ":active"},
+	{"Disabled", ":disabled"},
+	{"Placeholder", "::placeholder"},
+}
+
+// units are the Size units with a builder per Size property, e.g., WidthPX.
+var units = []string{"PX", "EM", "REM", "PG", "VH", "VW"}
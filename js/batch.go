@@ -0,0 +1,56 @@
+//go:build js && wasm
+
+package js
+
+import (
+	"syscall/js"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+)
+
+var (
+	// this type checks the implementation
+	_ browser.Batcher = (*batch)(nil)
+)
+
+// NewBatch returns a browser.Batcher backed by the interpreter which
+// batch.js installs as the global `browserBatch`, for use as Mounter.Batch.
+//
+// It panics if batch.js has not been loaded.
+func NewBatch() browser.Batcher {
+	u := js.Global().Get("browserBatch")
+	if u.IsUndefined() {
+		panic("browserBatch is undefined: batch.js must be loaded before the wasm")
+	}
+	return &batch{underlying: u}
+}
+
+type batch struct {
+	underlying js.Value
+	listener   js.Func
+}
+
+func (b *batch) Apply(root dom.Element, ops []byte) {
+	r, ok := root.(*element)
+	if !ok {
+		panic("must be *element")
+	}
+	b.underlying.Call("apply", r.underlying, string(ops))
+}
+
+func (b *batch) Element(id int) dom.Element {
+	u := b.underlying.Call("element", id)
+	if u.IsNull() {
+		return nil
+	}
+	return &element{underlying: u}
+}
+
+func (b *batch) Listen(f func(id int, t dom.EventType, e dom.Event)) {
+	b.listener = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		f(args[0].Int(), dom.EventType(args[1].String()), &event{args[2]})
+		return nil
+	})
+	b.underlying.Call("listen", b.listener)
+}
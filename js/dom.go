@@ -18,6 +18,7 @@ var (
 	_ browser.Browser   = (*bbrowser)(nil)
 	_ dom.Document      = (*document)(nil)
 	_ dom.Element       = (*element)(nil)
+	_ dom.StyleSheet    = (*element)(nil)
 	_ dom.EventListener = (*eventListener)(nil)
 )
 
@@ -60,6 +61,12 @@ func (d *document) Body() dom.Element {
 	}
 }
 
+func (d *document) Head() dom.Element {
+	return &element{
+		underlying: d.underlying.Get("head"),
+	}
+}
+
 func (d *document) GetElementByID(id string) (dom.Element, error) {
 	u := d.underlying.Call("getElementById", id)
 	if u.Equal(js.Null()) {
@@ -217,6 +224,15 @@ func (e *element) SetAttribute(key, val string) {
 	e.underlying.Call("setAttribute", key, val)
 }
 
+// https://developer.mozilla.org/en-US/docs/Web/API/Element/getAttribute
+func (e *element) GetAttribute(key string) string {
+	v := e.underlying.Call("getAttribute", key)
+	if v.IsNull() {
+		return ""
+	}
+	return v.String()
+}
+
 // https://developer.mozilla.org/en-US/docs/Web/API/Element/removeAttribute
 func (e *element) RemoveAttribute(key string) {
 	e.underlying.Call("removeAttribute", key)
@@ -289,6 +305,82 @@ type canvascontext struct {
 	underlying js.Value
 }
 
+func (c *canvascontext) Save()    { c.underlying.Call("save") }
+func (c *canvascontext) Restore() { c.underlying.Call("restore") }
+
+func (c *canvascontext) Transform(a, b, cc, d, e, f float64) {
+	c.underlying.Call("transform", a, b, cc, d, e, f)
+}
+
+func (c *canvascontext) SetFillStyle(s string)    { c.underlying.Set("fillStyle", s) }
+func (c *canvascontext) SetStrokeStyle(s string)  { c.underlying.Set("strokeStyle", s) }
+func (c *canvascontext) SetLineWidth(w float64)   { c.underlying.Set("lineWidth", w) }
+func (c *canvascontext) SetFont(s string)         { c.underlying.Set("font", s) }
+func (c *canvascontext) SetGlobalAlpha(a float64) { c.underlying.Set("globalAlpha", a) }
+func (c *canvascontext) ClearRect(x, y, w, h float64) {
+	c.underlying.Call("clearRect", x, y, w, h)
+}
+func (c *canvascontext) FillRect(x, y, w, h float64) {
+	c.underlying.Call("fillRect", x, y, w, h)
+}
+func (c *canvascontext) StrokeRect(x, y, w, h float64) {
+	c.underlying.Call("strokeRect", x, y, w, h)
+}
+
+func (c *canvascontext) BeginPath()          { c.underlying.Call("beginPath") }
+func (c *canvascontext) ClosePath()          { c.underlying.Call("closePath") }
+func (c *canvascontext) MoveTo(x, y float64) { c.underlying.Call("moveTo", x, y) }
+func (c *canvascontext) LineTo(x, y float64) { c.underlying.Call("lineTo", x, y) }
+func (c *canvascontext) Arc(x, y, r, start, end float64) {
+	c.underlying.Call("arc", x, y, r, start, end)
+}
+func (c *canvascontext) Fill()   { c.underlying.Call("fill") }
+func (c *canvascontext) Stroke() { c.underlying.Call("stroke") }
+
+func (c *canvascontext) FillText(s string, x, y float64) {
+	c.underlying.Call("fillText", s, x, y)
+}
+
+// See: https://developer.mozilla.org/en-US/docs/Web/API/CanvasRenderingContext2D/measureText
+func (c *canvascontext) MeasureText(s string) float64 {
+	return c.underlying.Call("measureText", s).Get("width").Float()
+}
+
+func (c *canvascontext) DrawImage(img dom.Element, x, y, w, h float64) {
+	e, ok := img.(*element)
+	if !ok {
+		panic("must be *element")
+	}
+	c.underlying.Call("drawImage", e.underlying, x, y, w, h)
+}
+
+// See: https://developer.mozilla.org/en-US/docs/Web/API/Element/getBoundingClientRect
+func (e *element) BoundingClientRect() dom.Rect {
+	r := e.underlying.Call("getBoundingClientRect")
+	return dom.Rect{
+		X:      r.Get("x").Float(),
+		Y:      r.Get("y").Float(),
+		Width:  r.Get("width").Float(),
+		Height: r.Get("height").Float(),
+	}
+}
+
+// See: https://developer.mozilla.org/en-US/docs/Web/API/CSSStyleSheet/insertRule
+func (e *element) InsertRule(rule string, index int) (err error) {
+	defer func() {
+		if r := recover(); r != nil { // a js.Error, e.g., a SyntaxError
+			err = fmt.Errorf("insertRule: %v", r)
+		}
+	}()
+	e.underlying.Get("sheet").Call("insertRule", rule, index)
+	return nil
+}
+
+// See: https://developer.mozilla.org/en-US/docs/Web/API/CSSStyleSheet/deleteRule
+func (e *element) DeleteRule(index int) {
+	e.underlying.Get("sheet").Call("deleteRule", index)
+}
+
 // See: https://developer.mozilla.org/en-US/docs/Web/API/Node/parentElement
 func (e *element) ParentElement() dom.Element {
 	x := e.underlying.Get("parentElement")
@@ -0,0 +1,93 @@
+package browser
+
+import "sync"
+
+// RequestRender asks DefaultLoop to render, see Loop.RequestRender.
+func RequestRender() {
+	DefaultLoop.RequestRender()
+}
+
+// DefaultLoop is the Loop to which RequestRender sends its requests. Apps
+// with one render goroutine set its fields and Run it.
+var DefaultLoop = new(Loop)
+
+// A Loop is the render goroutine: it handles each event from Events,
+// and each render request, by mounting a new view. As it is the only
+// goroutine calling Mount, its mounts are never concurrent.
+//
+//	l := browser.DefaultLoop
+//	l.Mounter = m
+//	l.Handle = s.Handle
+//	l.View = func() *browser.Node { return app.View(&s) }
+//	go browser.Dispatch(app.EventInitialize{})
+//	if err := l.Run(); err != nil {
+//		panic(err)
+//	}
+//
+// Render requests are the Loop's own, but Events is shared: a running
+// Loop handles any event dispatched, so run one Loop at a time which
+// handles events.
+type Loop struct {
+	Mounter *Mounter
+
+	// Handle, if set, is called with each event before rendering.
+	Handle func(Event)
+
+	// View builds the tree to mount.
+	View func() *Node
+
+	once    sync.Once
+	renders chan struct{} // holds at most one pending request
+	stop    chan struct{}
+	stopped sync.Once
+}
+
+func (l *Loop) init() {
+	l.once.Do(func() {
+		l.renders = make(chan struct{}, 1)
+		l.stop = make(chan struct{})
+	})
+}
+
+// RequestRender asks the Loop to render. It is safe to call from any
+// goroutine and never blocks. Requests made before the Loop gets to
+// render are coalesced into one render.
+//
+// Unlike `go Dispatch(nil)`, it does not start a goroutine per request,
+// nor go through the event handler.
+func (l *Loop) RequestRender() {
+	l.init()
+	select {
+	case l.renders <- struct{}{}:
+	default: // one is already pending
+	}
+}
+
+// Run handles events and render requests until Stop is called, or
+// a Mount fails, in which case it returns the error.
+func (l *Loop) Run() error {
+	l.init()
+
+	for {
+		select {
+		case e := <-Events:
+			if l.Handle != nil {
+				l.Handle(e)
+			}
+		case <-l.renders:
+		case <-l.stop:
+			return nil
+		}
+
+		if err := l.Mounter.Mount(l.View()); err != nil {
+			return err
+		}
+	}
+}
+
+// Stop stops Run, after any event or render in progress. It may be
+// called from any goroutine; calls after the first do nothing.
+func (l *Loop) Stop() {
+	l.init()
+	l.stopped.Do(func() { close(l.stop) })
+}
@@ -0,0 +1,102 @@
+package browser
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestConcurrentMount(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	blocking := &Node{
+		Type:     html.ElementNode,
+		DataAtom: atom.Canvas,
+		CanvasDraw: func(dom.CanvasRenderingContext2D) {
+			close(started)
+			<-release
+		},
+	}
+
+	errc := make(chan error)
+	go func() { errc <- m.Mount(blocking) }()
+	<-started
+
+	if err := m.Mount(&Node{Type: html.ElementNode, DataAtom: atom.Div}); err != ErrConcurrentMount {
+		t.Fatalf("concurrent Mount: got %v, want ErrConcurrentMount", err)
+	}
+
+	close(release)
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestLoop is most useful with -race: events are handled, and renders
+// requested, from other goroutines while the Loop mounts.
+func TestLoop(t *testing.T) {
+	d := domtest.NewDocument()
+
+	type click struct{}
+	var clicks int // only touched by the Loop's goroutine
+	l := &Loop{
+		Mounter: &Mounter{Document: d, Root: d.Body(), Delegate: true},
+		Handle: func(e Event) {
+			if _, ok := e.(click); ok {
+				clicks++
+			}
+		},
+	}
+	l.View = func() *Node {
+		return (&Node{Type: html.ElementNode, DataAtom: atom.Div}).
+			AttrValue(string(rune('a' + clicks%26))).
+			OnClick(func(dom.Event) { Dispatch(click{}) }) // not go: let wg wait for it
+	}
+
+	done := make(chan error)
+	go func() { done <- l.Run() }()
+	l.RequestRender()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.RequestRender()
+		}()
+		go func() {
+			defer wg.Done()
+			if cs := d.BodyElement().Children(); len(cs) > 0 {
+				cs[0].Dispatch(&domtest.Event{Type: dom.Click})
+			}
+		}()
+	}
+	wg.Wait()
+
+	l.Stop()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	l.Stop() // does nothing
+}
+
+func TestRequestRender(t *testing.T) {
+	var a, b Loop
+	a.RequestRender()
+	a.RequestRender()
+	if len(a.renders) != 1 || len(b.renders) != 0 {
+		t.Errorf("pending requests: got %d and %d, want 1 and 0", len(a.renders), len(b.renders))
+	}
+
+	RequestRender()
+	t.Cleanup(func() { <-DefaultLoop.renders })
+	if len(DefaultLoop.renders) != 1 || len(a.renders) != 1 {
+		t.Errorf("RequestRender: want a request of DefaultLoop only")
+	}
+}
@@ -1,8 +1,12 @@
 package browser
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"github.com/nlandolfi/browser/dom"
 	"golang.org/x/net/html"
@@ -11,24 +15,84 @@ import (
 
 // A Mounter attaches to a node in the DOM. Subsequent calls to
 // Mount will render the given browser.Node into the DOM element Root.
+//
+// Concurrency: Mount must only be called from one goroutine at a time,
+// the render goroutine; a concurrent call returns ErrConcurrentMount.
+// Mount records the DOM state of the tree it is given in its Nodes, and
+// reads it back from them on the next Mount, so a tree belongs to the
+// Mounter once mounted: it must not be mutated, or mounted elsewhere,
+// while the render goroutine may be mounting. Event handlers run on the
+// JS event goroutine; to change state and re-render, they should send
+// to the render goroutine with Dispatch or RequestRender, rather than
+// calling Mount themselves. Loop is a render goroutine doing just that.
 type Mounter struct {
 	// Root is the DOM element into which this Mounter renders.
 	//
 	// This is often the document body, but need not be.
 	Root dom.Element
 
-	// Document is the document object. We only use CreateElement and CreateTextNode.
+	// Document is the document object. We only use CreateElement, CreateTextNode,
+	// and Head, for the stylesheet of variant styles (see stylesheet.go).
 	//
 	// This is often js.DefaultBrowser. See the js package herein.
 	Document dom.Document
 
+	// Delegate, if set, routes events through one listener per event
+	// type on Root, rather than one listener per node and event type.
+	// Re-renders then never add or remove JS listeners. See delegate.go.
+	//
+	// It must be set before the first Mount.
+	Delegate bool
+
+	// Batch, if set, is used to apply each Mount's changes to the DOM
+	// with a single call, rather than calls per change. See batch.go.
+	//
+	// It must be set before the first Mount. Delegate is ignored when
+	// Batch is set, as batched listeners do not cross into Go per node.
+	Batch Batcher
+
+	// Classes, if set, writes each distinct Style as the rule of a class
+	// in a stylesheet, and gives the elements with it the class, rather
+	// than a style attribute. Large trees repeating the same styles then
+	// send each to the DOM once. See stylesheet.go.
+	//
+	// It must be set before the first Mount.
+	Classes bool
+
+	// mounting is set while a Mount is in progress
+	mounting atomic.Bool
+
+	// the state for the Delegate and Batch modes
+	nextID    int
+	delegated map[dom.EventType]dom.EventListener
+
+	// mu guards nodes and ns, which are read by event handlers
+	mu    sync.Mutex
+	nodes map[int]*Node
+	ns    string // prefixes the delegated ids, see delegate.go
+
+	// the managed stylesheet, see stylesheet.go
+	sheet dom.Element
+	rules map[string][]string // by class, of the classes in use
+	refs  map[string]int      // nodes by class
+	keys  []string            // the class of each rule in the sheet
+
 	// last is the Node last mounted, it is used to diff a new mount
 	// with the old, and decide on DOM changes. see `mount` below
 	last *Node
 }
 
+// ErrConcurrentMount is returned by Mount if another call to Mount,
+// on the same Mounter, is in progress.
+var ErrConcurrentMount = errors.New("browser.Mount: concurrent call to Mount")
+
 // Use Mount to mount the Node to the DOM element.
 func (m *Mounter) Mount(n *Node) error {
+	if !m.mounting.CompareAndSwap(false, true) {
+		return ErrConcurrentMount
+	}
+	defer m.mounting.Store(false)
+
 	return m.mount(n)
 }
 
@@ -40,6 +104,16 @@ func (m *Mounter) mount(n *Node) error {
 		return fmt.Errorf("browser.Mount: Mounter requires non-nil Root and Document")
 	}
 
+	if n != m.last {
+		n = fresh(n)
+	}
+
+	m.updateStylesheet(n)
+
+	if m.Batch != nil {
+		return m.mountBatch(n)
+	}
+
 	changes := reconcileWalker(m.Root, m.last, n)
 	// commented out 1/30/22
 	//s := make([]string, len(changes))
@@ -51,6 +125,10 @@ func (m *Mounter) mount(n *Node) error {
 		m.apply(c)
 	}
 
+	if m.Delegate {
+		m.index(n)
+	}
+
 	return nil
 }
 
@@ -109,6 +187,9 @@ func (m *Mounter) create(ref *Node) {
 		}
 		ref.renderedElement = m.Document.CreateElement(tagName)
 		ref.rendered = ref.renderedElement
+		if m.Delegate {
+			m.identify(ref)
+		}
 	case html.TextNode:
 		ref.rendered = m.Document.CreateTextNode(ref.Data)
 	default:
@@ -212,42 +293,15 @@ func (m *Mounter) listenerAdd(r *Node, t dom.EventType, l dom.EventHandler) {
 		panic("listenerAdd on a node with nil rendered")
 	}
 
-	el := r.rendered.AddEventListener(t, l)
-
-	switch t {
-	case dom.Click:
-		r.Handlers.click = el
-	case dom.DoubleClick:
-		r.Handlers.doubleClick = el
-	case dom.Drag:
-		r.Handlers.drag = el
-	case dom.MouseOver:
-		r.Handlers.mouseOver = el
-	case dom.MouseOut:
-		r.Handlers.mouseOut = el
-	case dom.Input:
-		r.Handlers.input = el
-	case dom.MouseDown:
-		r.Handlers.mouseDown = el
-	case dom.MouseMove:
-		r.Handlers.mouseMove = el
-	case dom.MouseUp:
-		r.Handlers.mouseUp = el
-	case dom.MouseEnter:
-		r.Handlers.mouseEnter = el
-	case dom.MouseLeave:
-		r.Handlers.mouseLeave = el
-	case dom.KeyUp:
-		r.Handlers.keyUp = el
-	case dom.KeyDown:
-		r.Handlers.keyDown = el
-	case dom.Drop:
-		r.Handlers.drop = el
-	case dom.DragOver:
-		r.Handlers.dragOver = el
-	default:
-		panic("unknown listener")
+	var el dom.EventListener
+	if m.Delegate && bubbles(t) && r.Type == html.ElementNode {
+		m.delegate(t)
+		el = delegatedListener{}
+	} else {
+		el = r.rendered.AddEventListener(t, l)
 	}
+
+	r.Handlers.setListener(t, el)
 }
 
 func (m *Mounter) canvasDraw(r *Node, draw func(c dom.CanvasRenderingContext2D)) {
@@ -255,13 +309,30 @@ func (m *Mounter) canvasDraw(r *Node, draw func(c dom.CanvasRenderingContext2D))
 		panic("canvasDraw on a node with nil renderedElemenet")
 	}
 
-	// need to figure out proper height and width...
-	c := r.renderedElement.CanvasContext(200, 100, 10.0)
+	w, h := canvasSize(r)
+	c := r.renderedElement.CanvasContext(w, h, 10.0)
 
 	// should be new context?
 	draw(c)
 }
 
+// canvasSize returns the size of the canvas n, from its width and
+// height attributes, e.g., those of a scene.Scene's node, or 200 by 100.
+func canvasSize(n *Node) (w, h float64) {
+	w, h = 200, 100
+	if v, ok := n.GetAttr("width"); ok {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			w = f
+		}
+	}
+	if v, ok := n.GetAttr("height"); ok {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			h = f
+		}
+	}
+	return w, h
+}
+
 func (m *Mounter) listenerDelete(r *Node, t dom.EventType, l dom.EventListener) {
 	if r.rendered == nil {
 		panic("listenerDelete on a node with nil rendered")
@@ -269,6 +340,9 @@ func (m *Mounter) listenerDelete(r *Node, t dom.EventType, l dom.EventListener)
 	if l == nil {
 		panic("listenerDelete event listener shouldn't be nil")
 	}
+	if _, ok := l.(delegatedListener); ok {
+		return // nothing was added to the element
+	}
 
 	r.rendered.RemoveEventListener(t, l)
 }
@@ -347,6 +421,10 @@ func reconcileWalker(base dom.Element, oldRoot, newRoot *Node) (changes []*chang
 
 	fakedParent := &Node{Type: html.ElementNode, rendered: base}
 
+	if oldRoot == newRoot { // e.g., a cached view; nothing could have changed
+		return
+	}
+
 	if oldRoot == nil {
 		changes = inserts(fakedParent, newRoot, 0)
 		return
@@ -381,6 +459,10 @@ func reconcileWalker(base dom.Element, oldRoot, newRoot *Node) (changes []*chang
 		// may then handle common diff cases like adding an alerty box above some other UI
 
 		for i := 0; i < len(old.Children) && i < len(new.Children); i++ {
+			if old.Children[i] == new.Children[i] {
+				continue // the same subtree, e.g., from a cached view
+			}
+			new.Children[i] = fresh(new.Children[i])
 			stack = append(stack, &nodePair{
 				parent: new, old: old.Children[i], new: new.Children[i],
 				level: top.level + 1,
@@ -388,6 +470,7 @@ func reconcileWalker(base dom.Element, oldRoot, newRoot *Node) (changes []*chang
 		}
 
 		for i := len(old.Children); i < len(new.Children); i++ {
+			new.Children[i] = fresh(new.Children[i])
 			changes = append(changes, inserts(new, new.Children[i], top.level+1)...) // with new.Children[i]
 		}
 
@@ -446,7 +529,7 @@ func reconcileAttr(ref *Node, old, new []*html.Attribute) (changes []*change) {
 // - I think you can set some of the .style properties of an element and it will work, but
 // potentiall some of the values do not. Also the code was super long to check each of
 func diffStyles(ref *Node, from, to *Style, level int) (changes []*change) {
-	if from == nil && to == nil {
+	if from == nil && to == nil || ref.classedStyle {
 		return
 	}
 
@@ -459,7 +542,7 @@ func diffStyles(ref *Node, from, to *Style, level int) (changes []*change) {
 		})
 	}
 
-	if from == nil && to != nil || *from != *to {
+	if from == nil && to != nil || !from.Equal(to) {
 		changes = append(changes, &change{
 			Type: attrSet,
 			Ref:  ref,
@@ -472,890 +555,49 @@ func diffStyles(ref *Node, from, to *Style, level int) (changes []*change) {
 }
 
 // reconcileHandlers {{{
-
-func getClick(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.Click
-}
-
-func getDoubleClick(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.DoubleClick
-}
-
-func getDrag(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.Drag
-}
-
-func getMouseOver(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseOver
-}
-
-func getMouseOut(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseOut
-}
-
-func getMouseDown(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseDown
-}
-
-func getMouseMove(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseMove
-}
-
-func getMouseUp(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseUp
-}
-
-func getMouseEnter(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseEnter
-}
-
-func getMouseLeave(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.MouseLeave
-}
-
-func getInput(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.Input
-}
-
-func getKeyUp(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.KeyUp
-}
-
-func getKeyDown(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.KeyDown
-}
-
-func getDrop(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.Drop
-}
-
-func getDragOver(n *Node) dom.EventHandler {
-	if n == nil {
-		return nil
-	}
-
-	return n.Handlers.DragOver
-}
-
-const cacheListeners bool = true
+//
+// A node's handlers are usually new closures each render, and func
+// values can not be compared, so rather than a listener calling the
+// handler, each node has one listener per event type, added once, which
+// forwards to the current handler (see Handlers.forward). As a node is
+// mutated into its successor, the listeners, and the forwarder, are
+// handed on, and the forwarder pointed at the new handlers. Listeners are
+// only added or removed when a node gains or loses a handler for a type.
+//
+// In the Delegate and Batch modes, the listener added stands in for one
+// which looks up the current node by id, to the same effect.
 
 func reconcileHandlers(old, new *Node) (changes []*change) {
-	oldClick, newClick := getClick(old), getClick(new)
-	if oldClick == nil && newClick == nil {
-		// no op
-	}
-	if oldClick != nil && newClick == nil {
-		if old.Handlers.click == nil {
-			panic("listener has Click but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.Click,
-			OldListener: old.Handlers.click,
-		})
-	}
-	if oldClick == nil && newClick != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.Click,
-			NewListener: new.Handlers.Click,
-		})
-	}
-	if oldClick != nil && newClick != nil && &oldClick != &newClick {
-		//	&& ((new.Handlers.ClickCacheKey != "" || old.Handlers.ClickCacheKey != "") && new.Handlers.ClickCacheKey != old.Handlers.ClickCacheKey) {
-
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.ClickCacheKey != "" && old.Handlers.ClickCacheKey != "" && new.Handlers.ClickCacheKey == old.Handlers.ClickCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.Click = old.Handlers.Click // not sure if we need to move this
-			new.Handlers.click = old.Handlers.click // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.click == nil {
-				panic("listener has Click but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.Click,
-				OldListener: old.Handlers.click,
-			})
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.Click,
-				NewListener: new.Handlers.Click,
-			})
-		}
-	}
-
-	oldDoubleClick, newDoubleClick := getDoubleClick(old), getDoubleClick(new)
-	if oldDoubleClick == nil && newDoubleClick == nil {
-		// no op
-	}
-	if oldDoubleClick != nil && newDoubleClick == nil {
-		if old.Handlers.doubleClick == nil {
-			panic("listener has DoubleClick but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.DoubleClick,
-			OldListener: old.Handlers.doubleClick,
-		})
-	}
-	if oldDoubleClick == nil && newDoubleClick != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.DoubleClick,
-			NewListener: new.Handlers.DoubleClick,
-		})
-	}
-	if oldDoubleClick != nil && newDoubleClick != nil && &oldDoubleClick != &newDoubleClick {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.DoubleClickCacheKey != "" && old.Handlers.DoubleClickCacheKey != "" && new.Handlers.DoubleClickCacheKey == old.Handlers.DoubleClickCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.DoubleClick = old.Handlers.DoubleClick // not sure if we need to move this
-			new.Handlers.doubleClick = old.Handlers.doubleClick // definitely need to move this, was a bug
-		} else {
-
-			if old.Handlers.doubleClick == nil {
-				panic("listener has DoubleClick but no underlying event listener")
-			}
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.DoubleClick,
-				OldListener: old.Handlers.doubleClick,
-			})
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.DoubleClick,
-				NewListener: new.Handlers.DoubleClick,
-			})
-		}
-	}
-
-	oldDrag, newDrag := getDrag(old), getDrag(new)
-	if oldDrag == nil && newDrag == nil {
-		// no op
-	}
-	if oldDrag != nil && newDrag == nil {
-		if old.Handlers.drag == nil {
-			panic("listener has Drag but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.Drag,
-			OldListener: old.Handlers.drag,
-		})
-	}
-	if oldDrag == nil && newDrag != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.Drag,
-			NewListener: new.Handlers.Drag,
-		})
-	}
-	if oldDrag != nil && newDrag != nil && &oldDrag != &newDrag {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.DragCacheKey != "" && old.Handlers.DragCacheKey != "" && new.Handlers.DragCacheKey == old.Handlers.DragCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.Drag = old.Handlers.Drag // not sure if we need to move this
-			new.Handlers.drag = old.Handlers.drag // definitely need to move this, was a bug
-		} else {
-
-			if old.Handlers.drag == nil {
-				panic("listener has Drag but no underlying event listener")
-			}
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.Drag,
-				OldListener: old.Handlers.drag,
-			})
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.Drag,
-				NewListener: new.Handlers.Drag,
-			})
-		}
-	}
-
-	oldMouseOver, newMouseOver := getMouseOver(old), getMouseOver(new)
-	if oldMouseOver == nil && newMouseOver == nil {
-		// no op
-	}
-	if oldMouseOver != nil && newMouseOver == nil {
-		if old.Handlers.mouseOver == nil {
-			panic("listener has MouseOver but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseOver,
-			OldListener: old.Handlers.mouseOver,
-		})
-	}
-	if oldMouseOver == nil && newMouseOver != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseOver,
-			NewListener: new.Handlers.MouseOver,
-		})
-	}
-	if oldMouseOver != nil && newMouseOver != nil && &oldMouseOver != &newMouseOver {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseOverCacheKey != "" && old.Handlers.MouseOverCacheKey != "" && new.Handlers.MouseOverCacheKey == old.Handlers.MouseOverCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseOver = old.Handlers.MouseOver // not sure if we need to move this
-			new.Handlers.mouseOver = old.Handlers.mouseOver // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseOver == nil {
-				panic("listener has MouseOver but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseOver,
-				OldListener: old.Handlers.mouseOver,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseOver,
-				NewListener: new.Handlers.MouseOver,
-			})
-		}
-	}
-
-	oldMouseOut, newMouseOut := getMouseOut(old), getMouseOut(new)
-	if oldMouseOut == nil && newMouseOut == nil {
-		// no op
-	}
-	if oldMouseOut != nil && newMouseOut == nil {
-		if old.Handlers.mouseOut == nil {
-			panic("listener has MouseOut but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseOut,
-			OldListener: old.Handlers.mouseOut,
-		})
-	}
-	if oldMouseOut == nil && newMouseOut != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseOut,
-			NewListener: new.Handlers.MouseOut,
-		})
-	}
-	if oldMouseOut != nil && newMouseOut != nil && &oldMouseOut != &newMouseOut {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseOutCacheKey != "" && old.Handlers.MouseOutCacheKey != "" && new.Handlers.MouseOutCacheKey == old.Handlers.MouseOutCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseOut = old.Handlers.MouseOut // not sure if we need to move this
-			new.Handlers.mouseOut = old.Handlers.mouseOut // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseOut == nil {
-				panic("listener has MouseOut but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseOut,
-				OldListener: old.Handlers.mouseOut,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseOut,
-				NewListener: new.Handlers.MouseOut,
-			})
-		}
-	}
-
-	oldMouseDown, newMouseDown := getMouseDown(old), getMouseDown(new)
-	if oldMouseDown == nil && newMouseDown == nil {
-		// no op
-	}
-	if oldMouseDown != nil && newMouseDown == nil {
-		if old.Handlers.mouseDown == nil {
-			panic("listener has MouseDown but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseDown,
-			OldListener: old.Handlers.mouseDown,
-		})
-	}
-	if oldMouseDown == nil && newMouseDown != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseDown,
-			NewListener: new.Handlers.MouseDown,
-		})
-	}
-	if oldMouseDown != nil && newMouseDown != nil && &oldMouseDown != &newMouseDown {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseDownCacheKey != "" && old.Handlers.MouseDownCacheKey != "" && new.Handlers.MouseDownCacheKey == old.Handlers.MouseDownCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseDown = old.Handlers.MouseDown // not sure if we need to move this
-			new.Handlers.mouseDown = old.Handlers.mouseDown // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseDown == nil {
-				panic("listener has MouseDown but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseDown,
-				OldListener: old.Handlers.mouseDown,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseDown,
-				NewListener: new.Handlers.MouseDown,
-			})
-		}
-	}
-
-	oldMouseMove, newMouseMove := getMouseMove(old), getMouseMove(new)
-	if oldMouseMove == nil && newMouseMove == nil {
-		// no op
-	}
-	if oldMouseMove != nil && newMouseMove == nil {
-		if old.Handlers.mouseMove == nil {
-			panic("listener has MouseMove but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseMove,
-			OldListener: old.Handlers.mouseMove,
-		})
-	}
-	if oldMouseMove == nil && newMouseMove != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseMove,
-			NewListener: new.Handlers.MouseMove,
-		})
-	}
-	if oldMouseMove != nil && newMouseMove != nil && &oldMouseMove != &newMouseMove {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseMoveCacheKey != "" && old.Handlers.MouseMoveCacheKey != "" && new.Handlers.MouseMoveCacheKey == old.Handlers.MouseMoveCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseMove = old.Handlers.MouseMove // not sure if we need to move this
-			new.Handlers.mouseMove = old.Handlers.mouseMove // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseMove == nil {
-				panic("listener has MouseMove but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseMove,
-				OldListener: old.Handlers.mouseMove,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseMove,
-				NewListener: new.Handlers.MouseMove,
-			})
-		}
-	}
-
-	oldMouseUp, newMouseUp := getMouseUp(old), getMouseUp(new)
-	if oldMouseUp == nil && newMouseUp == nil {
-		// no op
-	}
-	if oldMouseUp != nil && newMouseUp == nil {
-		if old.Handlers.mouseUp == nil {
-			panic("listener has MouseUp but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseUp,
-			OldListener: old.Handlers.mouseUp,
-		})
-	}
-	if oldMouseUp == nil && newMouseUp != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseUp,
-			NewListener: new.Handlers.MouseUp,
-		})
-	}
-	if oldMouseUp != nil && newMouseUp != nil && &oldMouseUp != &newMouseUp {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseUpCacheKey != "" && old.Handlers.MouseUpCacheKey != "" && new.Handlers.MouseUpCacheKey == old.Handlers.MouseUpCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseUp = old.Handlers.MouseUp // not sure if we need to move this
-			new.Handlers.mouseUp = old.Handlers.mouseUp // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseUp == nil {
-				panic("listener has MouseUp but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseUp,
-				OldListener: old.Handlers.mouseUp,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseUp,
-				NewListener: new.Handlers.MouseUp,
-			})
-		}
-	}
-
-	oldMouseEnter, newMouseEnter := getMouseEnter(old), getMouseEnter(new)
-	if oldMouseEnter == nil && newMouseEnter == nil {
-		// no op
-	}
-	if oldMouseEnter != nil && newMouseEnter == nil {
-		if old.Handlers.mouseEnter == nil {
-			panic("listener has MouseEnter but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseEnter,
-			OldListener: old.Handlers.mouseEnter,
-		})
-	}
-	if oldMouseEnter == nil && newMouseEnter != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseEnter,
-			NewListener: new.Handlers.MouseEnter,
-		})
-	}
-	if oldMouseEnter != nil && newMouseEnter != nil && &oldMouseEnter != &newMouseEnter {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseEnterCacheKey != "" && old.Handlers.MouseEnterCacheKey != "" && new.Handlers.MouseEnterCacheKey == old.Handlers.MouseEnterCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseEnter = old.Handlers.MouseEnter // not sure if we need to move this
-			new.Handlers.mouseEnter = old.Handlers.mouseEnter // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseEnter == nil {
-				panic("listener has MouseEnter but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseEnter,
-				OldListener: old.Handlers.mouseEnter,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseEnter,
-				NewListener: new.Handlers.MouseEnter,
-			})
-		}
-	}
-
-	oldMouseLeave, newMouseLeave := getMouseLeave(old), getMouseLeave(new)
-	if oldMouseLeave == nil && newMouseLeave == nil {
-		// no op
-	}
-	if oldMouseLeave != nil && newMouseLeave == nil {
-		if old.Handlers.mouseLeave == nil {
-			panic("listener has MouseLeave but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.MouseLeave,
-			OldListener: old.Handlers.mouseLeave,
-		})
-	}
-	if oldMouseLeave == nil && newMouseLeave != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.MouseLeave,
-			NewListener: new.Handlers.MouseLeave,
-		})
-	}
-	if oldMouseLeave != nil && newMouseLeave != nil && &oldMouseLeave != &newMouseLeave {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.MouseLeaveCacheKey != "" && old.Handlers.MouseLeaveCacheKey != "" && new.Handlers.MouseLeaveCacheKey == old.Handlers.MouseLeaveCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.MouseLeave = old.Handlers.MouseLeave // not sure if we need to move this
-			new.Handlers.mouseLeave = old.Handlers.mouseLeave // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.mouseLeave == nil {
-				panic("listener has MouseLeave but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.MouseLeave,
-				OldListener: old.Handlers.mouseLeave,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.MouseLeave,
-				NewListener: new.Handlers.MouseLeave,
-			})
-		}
-	}
-
-	oldInput, newInput := getInput(old), getInput(new)
-	if oldInput == nil && newInput == nil {
-		// no op
-	}
-	if oldInput != nil && newInput == nil {
-		if old.Handlers.input == nil {
-			panic("listener has Input but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.Input,
-			OldListener: old.Handlers.input,
-		})
-	}
-	if oldInput == nil && newInput != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.Input,
-			NewListener: new.Handlers.Input,
-		})
-	}
-	if oldInput != nil && newInput != nil && &oldInput != &newInput {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.InputCacheKey != "" && old.Handlers.InputCacheKey != "" && new.Handlers.InputCacheKey == old.Handlers.InputCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.Input = old.Handlers.Input // not sure if we need to move this
-			new.Handlers.input = old.Handlers.input // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.input == nil {
-				panic("listener has Input but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.Input,
-				OldListener: old.Handlers.input,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.Input,
-				NewListener: new.Handlers.Input,
-			})
-		}
-	}
-
-	oldKeyUp, newKeyUp := getKeyUp(old), getKeyUp(new)
-	if oldKeyUp == nil && newKeyUp == nil {
-		// no op
-	}
-	if oldKeyUp != nil && newKeyUp == nil {
-		if old.Handlers.keyUp == nil {
-			panic("listener has KeyUp but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.KeyUp,
-			OldListener: old.Handlers.keyUp,
-		})
-	}
-	if oldKeyUp == nil && newKeyUp != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.KeyUp,
-			NewListener: new.Handlers.KeyUp,
-		})
-	}
-	if oldKeyUp != nil && newKeyUp != nil && &oldKeyUp != &newKeyUp {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.KeyUpCacheKey != "" && old.Handlers.KeyUpCacheKey != "" && new.Handlers.KeyUpCacheKey == old.Handlers.KeyUpCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.KeyUp = old.Handlers.KeyUp // not sure if we need to move this
-			new.Handlers.keyUp = old.Handlers.keyUp // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.keyUp == nil {
-				panic("listener has KeyUp but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.KeyUp,
-				OldListener: old.Handlers.keyUp,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.KeyUp,
-				NewListener: new.Handlers.KeyUp,
-			})
-		}
-	}
-
-	oldKeyDown, newKeyDown := getKeyDown(old), getKeyDown(new)
-	if oldKeyDown == nil && newKeyDown == nil {
-		// no op
-	}
-	if oldKeyDown != nil && newKeyDown == nil {
-		if old.Handlers.keyDown == nil {
-			panic("listener has KeyDown but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.KeyDown,
-			OldListener: old.Handlers.keyDown,
-		})
-	}
-	if oldKeyDown == nil && newKeyDown != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.KeyDown,
-			NewListener: new.Handlers.KeyDown,
-		})
+	if old != nil && old.Handlers.fwd != nil {
+		new.Handlers.fwd = old.Handlers.fwd
+		new.Handlers.fwd.set(&new.Handlers)
 	}
-	if oldKeyDown != nil && newKeyDown != nil && &oldKeyDown != &newKeyDown {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.KeyDownCacheKey != "" && old.Handlers.KeyDownCacheKey != "" && new.Handlers.KeyDownCacheKey == old.Handlers.KeyDownCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.KeyDown = old.Handlers.KeyDown // not sure if we need to move this
-			new.Handlers.keyDown = old.Handlers.keyDown // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.keyDown == nil {
-				panic("listener has KeyDown but no underlying event listener")
-			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.KeyDown,
-				OldListener: old.Handlers.keyDown,
-			})
 
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.KeyDown,
-				NewListener: new.Handlers.KeyDown,
-			})
-		}
-	}
-
-	oldDrop, newDrop := getDrop(old), getDrop(new)
-	if oldDrop == nil && newDrop == nil {
-		// no op
-	}
-	if oldDrop != nil && newDrop == nil {
-		if old.Handlers.drop == nil {
-			panic("listener has Drop but no underlying event listener")
-		}
-
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.Drop,
-			OldListener: old.Handlers.drop,
-		})
-	}
-	if oldDrop == nil && newDrop != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.Drop,
-			NewListener: new.Handlers.Drop,
-		})
-	}
-	if oldDrop != nil && newDrop != nil && &oldDrop != &newDrop {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.DropCacheKey != "" && old.Handlers.DropCacheKey != "" && new.Handlers.DropCacheKey == old.Handlers.DropCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.Drop = old.Handlers.Drop // not sure if we need to move this
-			new.Handlers.drop = old.Handlers.drop // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.drop == nil {
-				panic("listener has Drop but no underlying event listener")
+	for i, t := range eventTypes {
+		var had dom.EventListener
+		if old != nil && old.Handlers.Get(t) != nil {
+			if had = old.Handlers.listeners[i]; had == nil {
+				panic(fmt.Sprintf("node has a %s handler but no underlying event listener", t))
 			}
-
-			changes = append(changes, &change{
-				Type:        listenerDelete,
-				Ref:         old,
-				EventType:   dom.Drop,
-				OldListener: old.Handlers.drop,
-			})
-
-			changes = append(changes, &change{
-				Type:        listenerAdd,
-				Ref:         new,
-				EventType:   dom.Drop,
-				NewListener: new.Handlers.Drop,
-			})
-		}
-	}
-
-	oldDragOver, newDragOver := getDragOver(old), getDragOver(new)
-	if oldDragOver == nil && newDragOver == nil {
-		// no op
-	}
-	if oldDragOver != nil && newDragOver == nil {
-		if old.Handlers.dragOver == nil {
-			panic("listener has DragOver but no underlying event listener")
 		}
+		wants := new.Handlers.Get(t) != nil
 
-		changes = append(changes, &change{
-			Type:        listenerDelete,
-			Ref:         old,
-			EventType:   dom.DragOver,
-			OldListener: old.Handlers.dragOver,
-		})
-	}
-	if oldDragOver == nil && newDragOver != nil {
-		changes = append(changes, &change{
-			Type:        listenerAdd,
-			Ref:         new,
-			EventType:   dom.DragOver,
-			NewListener: new.Handlers.DragOver,
-		})
-	}
-	if oldDragOver != nil && newDragOver != nil && &oldDragOver != &newDragOver {
-		// last attempt, check the cache
-		if cacheListeners && new.Handlers.DragOverCacheKey != "" && old.Handlers.DragOverCacheKey != "" && new.Handlers.DragOverCacheKey == old.Handlers.DragOverCacheKey {
-			// no need to syscall listener adding!
-			new.Handlers.DragOver = old.Handlers.DragOver // not sure if we need to move this
-			new.Handlers.dragOver = old.Handlers.dragOver // definitely need to move this, was a bug
-		} else {
-			if old.Handlers.dragOver == nil {
-				panic("listener has DragOver but no underlying event listener")
-			}
-
+		switch {
+		case had != nil && wants:
+			new.Handlers.listeners[i] = had // it forwards to new's handler
+		case had != nil:
 			changes = append(changes, &change{
 				Type:        listenerDelete,
 				Ref:         old,
-				EventType:   dom.DragOver,
-				OldListener: old.Handlers.dragOver,
+				EventType:   t,
+				OldListener: had,
 			})
-
+		case wants:
 			changes = append(changes, &change{
 				Type:        listenerAdd,
 				Ref:         new,
-				EventType:   dom.DragOver,
-				NewListener: new.Handlers.DragOver,
+				EventType:   t,
+				NewListener: new.Handlers.forward(t),
 			})
 		}
 	}
@@ -1414,12 +656,13 @@ func reconcile(parent, old, new *Node, level int) (changes []*change, replaced b
 		// as the old one
 		new.rendered = old.rendered
 		new.renderedElement = old.renderedElement
+		new.id = old.id
 
 		//log.Printf("old node! %+v with style %s", old, old.Style.Val())
 		//log.Printf("new node! %+v with style %s", new, new.Style.Val())
 		changes = append(changes, diffStyles(new, &old.Style, &new.Style, level)...)
 		changes = append(changes, reconcileHandlers(old, new)...)
-		changes = append(changes, reconcileAttr(new, old.Attr, new.Attr)...)
+		changes = append(changes, reconcileAttr(new, attrs(old), attrs(new))...)
 		changes = append(changes, reconcileCanvasDraw(old, new)...)
 	case html.TextNode:
 		if old.Data != new.Data {
@@ -1434,6 +677,7 @@ func reconcile(parent, old, new *Node, level int) (changes []*change, replaced b
 		}
 
 		new.rendered = old.rendered
+		new.id = old.id
 	default:
 		panic("unknown node type")
 	}
@@ -1455,18 +699,40 @@ func replaces(parent, old, new *Node, level int) (changes []*change) {
 	// only elements can have style or attrs or are canvases
 	if new.Type == html.ElementNode {
 		changes = append(changes, diffStyles(new, nil, &new.Style, level)...)
-		changes = append(changes, reconcileAttr(new, nil, new.Attr)...)
+		changes = append(changes, reconcileAttr(new, nil, attrs(new))...)
 		changes = append(changes, reconcileCanvasDraw(nil, new)...)
 	}
 
 	// insert each of the new node's children
-	for _, c := range new.Children {
+	for i, c := range new.Children {
+		c = fresh(c)
+		new.Children[i] = c
 		changes = append(changes, inserts(new, c, level+1)...)
 	}
 
 	return
 }
 
+// fresh returns n, or a copy of n if n has already been rendered.
+//
+// A *Node may be mounted more than once, e.g., when a view caches
+// a subtree (see the signals package). Where it is mounted in the
+// same position as before, the walker skips it. Anywhere else, its
+// rendered element (which may have since been mutated into some other
+// node, or be in use elsewhere) can not be reused, so we reconcile
+// a copy. The copy's children are made fresh as they are visited.
+func fresh(n *Node) *Node {
+	if n.rendered == nil && n.id == 0 {
+		return n
+	}
+
+	c := *n
+	c.rendered, c.renderedElement, c.id = nil, nil, 0
+	c.Handlers.listeners, c.Handlers.fwd = [len(eventTypes)]dom.EventListener{}, nil
+	c.Children = append([]*Node(nil), n.Children...)
+	return &c
+}
+
 func inserts(into, root *Node, level int) (changes []*change) {
 	if into == nil {
 		panic("into can't be nil")
@@ -1490,10 +756,12 @@ func inserts(into, root *Node, level int) (changes []*change) {
 
 	changes = append(changes, diffStyles(root, nil, &root.Style, level)...) // reconcile the root's styles against empty styles
 	changes = append(changes, reconcileHandlers(nil, root)...)              // reconcile the root's listeners against empty listeners
-	changes = append(changes, reconcileAttr(root, nil, root.Attr)...)       // reconcile the root's attr's against empty attrs
+	changes = append(changes, reconcileAttr(root, nil, attrs(root))...)     // reconcile the root's attr's against empty attrs
 	changes = append(changes, reconcileCanvasDraw(nil, root)...)
 
-	for _, c := range root.Children { // recurse, for each child
+	for i, c := range root.Children { // recurse, for each child
+		c = fresh(c)
+		root.Children[i] = c
 		changes = append(changes, inserts(root, c, level+1)...)
 	}
 
@@ -0,0 +1,51 @@
+package browser
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestHandlers(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+
+	var got []int
+	view := func(i int, click bool) *Node {
+		n := &Node{Type: html.ElementNode, DataAtom: atom.Div}
+		if click {
+			n.OnClick(func(dom.Event) { got = append(got, i) }) // a new closure each render
+		}
+		return n
+	}
+
+	for i, c := range []struct {
+		click     bool
+		listeners int
+		want      []int
+	}{
+		{true, 1, []int{0}},
+		{true, 1, []int{1}},
+		{true, 1, []int{2}},
+		{false, 0, nil},
+		{true, 1, []int{4}},
+	} {
+		if err := m.Mount(view(i, c.click)); err != nil {
+			t.Fatal(err)
+		}
+
+		div := d.BodyElement().Children()[0]
+		if n := div.Listeners(dom.Click); n != c.listeners {
+			t.Fatalf("mount %d: div has %d click listeners, want %d", i, n, c.listeners)
+		}
+
+		got = nil
+		div.Dispatch(&domtest.Event{Type: dom.Click})
+		if len(got) != len(c.want) || len(got) > 0 && got[0] != c.want[0] {
+			t.Fatalf("mount %d: handlers called %v, want %v", i, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package browser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nlandolfi/browser/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// JSON {{{
+//
+// Nodes encode as JSON in a stable format, for fixtures and for sending
+// rendered views between processes, e.g.,
+//
+//	{
+//		"type": "element",
+//		"tag": "button",
+//		"attrs": [{"key": "class", "val": "primary"}],
+//		"style": {
+//			"properties": {"color": "white", "padding": "4.000000px"},
+//			"variants": {":hover": {"properties": {"color": "gray"}}},
+//			"breakpoints": [{"minWidth": "768.000000px", "style": {...}}]
+//		},
+//		"handlers": ["click"],
+//		"children": [{"type": "text", "text": "Save"}]
+//	}
+//
+// A style's properties are its declarations, as Val writes them. The
+// frames of its animation's keyframes, if made with NewKeyframes, follow,
+// e.g.,
+//
+//	"keyframes": [
+//		{"offset": "0%", "style": {"properties": {"opacity": "0"}}},
+//		{"offset": "100%", "style": {"properties": {"opacity": "1"}}}
+//	]
+//
+// and decoding makes the keyframes again from them. Handlers
+// and CanvasDraw can't be encoded: the format records which events have
+// handlers, and whether there is a CanvasDraw, and decoding sets them to
+// placeholders which do nothing. The Mounter's state isn't encoded.
+
+type nodeJSON struct {
+	Type     string          `json:"type"`
+	Tag      string          `json:"tag,omitempty"`
+	Text     string          `json:"text,omitempty"`
+	Attrs    []attrJSON      `json:"attrs,omitempty"`
+	Style    *styleJSON      `json:"style,omitempty"`
+	Handlers []dom.EventType `json:"handlers,omitempty"`
+	Canvas   bool            `json:"canvas,omitempty"`
+	Children []*Node         `json:"children,omitempty"`
+}
+
+type attrJSON struct {
+	Namespace string `json:"namespace,omitempty"`
+	Key       string `json:"key"`
+	Val       string `json:"val"`
+}
+
+type styleJSON struct {
+	Properties  map[string]string     `json:"properties,omitempty"`
+	Keyframes   []keyframeJSON        `json:"keyframes,omitempty"`
+	Variants    map[string]*styleJSON `json:"variants,omitempty"`
+	Breakpoints []breakpointJSON      `json:"breakpoints,omitempty"`
+}
+
+type keyframeJSON struct {
+	Offset string     `json:"offset"`
+	Style  *styleJSON `json:"style"`
+}
+
+type breakpointJSON struct {
+	MinWidth string     `json:"minWidth"`
+	Style    *styleJSON `json:"style"`
+}
+
+// EncodeJSON writes the tree rooted at n as indented JSON.
+func EncodeJSON(w io.Writer, n *Node) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "\t")
+	e.SetEscapeHTML(false)
+	if err := e.Encode(n); err != nil {
+		return fmt.Errorf("browser.EncodeJSON: %v", err)
+	}
+	return nil
+}
+
+// DecodeJSON reads a tree written by EncodeJSON.
+func DecodeJSON(r io.Reader) (*Node, error) {
+	n := new(Node)
+	if err := json.NewDecoder(r).Decode(n); err != nil {
+		return nil, fmt.Errorf("browser.DecodeJSON: %v", err)
+	}
+	return n, nil
+}
+
+func (n *Node) MarshalJSON() ([]byte, error) {
+	j := nodeJSON{Children: n.Children}
+	switch n.Type {
+	case html.ElementNode:
+		j.Type, j.Tag = "element", n.Data
+		if j.Tag == "" {
+			j.Tag = n.DataAtom.String()
+		}
+	case html.TextNode:
+		j.Type, j.Text = "text", n.Data
+	default:
+		return nil, fmt.Errorf("browser.Node: unknown Type: %#v", n.Type)
+	}
+
+	for _, a := range n.Attr {
+		j.Attrs = append(j.Attrs, attrJSON{a.Namespace, a.Key, a.Val})
+	}
+	j.Style = styleToJSON(&n.Style)
+	j.Handlers = n.Handlers.Types()
+	j.Canvas = n.CanvasDraw != nil
+
+	// HTML is escaped, or not, by the caller's encoder
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(j); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+func (n *Node) UnmarshalJSON(data []byte) error {
+	var j nodeJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	*n = Node{Children: j.Children}
+	switch j.Type {
+	case "element":
+		n.Type = html.ElementNode
+		if n.DataAtom = atom.Lookup([]byte(j.Tag)); n.DataAtom == 0 {
+			n.Data = j.Tag
+		}
+	case "text":
+		n.Type, n.Data = html.TextNode, j.Text
+	default:
+		return fmt.Errorf("browser.Node: unknown type %q", j.Type)
+	}
+
+	for _, a := range j.Attrs {
+		n.Attr = append(n.Attr, &html.Attribute{Namespace: a.Namespace, Key: a.Key, Val: a.Val})
+	}
+	if j.Style != nil {
+		s, err := styleFromJSON(j.Style)
+		if err != nil {
+			return err
+		}
+		n.Style = *s
+	}
+	for _, t := range j.Handlers {
+		if !knownEventType(t) {
+			return fmt.Errorf("browser.Node: unknown event type %q", t)
+		}
+		n.Handlers.set(t, func(dom.Event) {})
+	}
+	if j.Canvas {
+		n.CanvasDraw = func(dom.CanvasRenderingContext2D) {}
+	}
+
+	return nil
+}
+
+func knownEventType(t dom.EventType) bool {
+	for _, u := range eventTypes {
+		if t == u {
+			return true
+		}
+	}
+	return false
+}
+
+// styleToJSON returns the JSON of s, or nil if s sets nothing.
+func styleToJSON(s *Style) *styleJSON {
+	j := &styleJSON{}
+	for _, d := range split(s.Val(), ';') {
+		if name, value, ok := strings.Cut(d, ":"); ok {
+			if j.Properties == nil {
+				j.Properties = make(map[string]string)
+			}
+			j.Properties[name] = value
+		}
+	}
+	offsets, declarations := s.Animation.Keyframes.frames()
+	for i, o := range offsets {
+		f, _ := ParseStyle(declarations[i])
+		fj := styleToJSON(&f)
+		if fj == nil {
+			fj = &styleJSON{}
+		}
+		j.Keyframes = append(j.Keyframes, keyframeJSON{o, fj})
+	}
+	for _, v := range s.variants() {
+		if j.Variants == nil {
+			j.Variants = make(map[string]*styleJSON)
+		}
+		j.Variants[v.selector] = styleToJSON(v.style)
+		if j.Variants[v.selector] == nil {
+			j.Variants[v.selector] = &styleJSON{}
+		}
+	}
+	for i := range s.Breakpoints {
+		b := &s.Breakpoints[i]
+		bj := styleToJSON(&b.Style)
+		if bj == nil {
+			bj = &styleJSON{}
+		}
+		j.Breakpoints = append(j.Breakpoints, breakpointJSON{b.MinWidth.String(), bj})
+	}
+
+	if j.Properties == nil && j.Keyframes == nil && j.Variants == nil && j.Breakpoints == nil {
+		return nil
+	}
+	return j
+}
+
+// keyframesFromJSON makes the keyframes of the frames, which are named
+// by their hash, as NewKeyframes names them.
+func keyframesFromJSON(fs []keyframeJSON) (Keyframes, error) {
+	var body strings.Builder
+	last := -1.0
+	for _, f := range fs {
+		v := strings.TrimSuffix(f.Offset, "%")
+		o, err := strconv.ParseFloat(v, 64)
+		if err != nil || numberPrefix(v) != len(v) || v == f.Offset || o < 0 || o > 100 || o < last {
+			return Keyframes{}, fmt.Errorf("browser.Node: invalid keyframe offset %q", f.Offset)
+		}
+		last = o
+
+		var style Style
+		if f.Style != nil {
+			s, err := styleFromJSON(f.Style)
+			if err != nil {
+				return Keyframes{}, err
+			}
+			style = *s
+		}
+		body.WriteString(f.Offset + "{" + declarations(style.Val(), "") + "}")
+	}
+	return keyframesOf(body.String()), nil
+}
+
+func styleFromJSON(j *styleJSON) (*Style, error) {
+	names := make([]string, 0, len(j.Properties))
+	for name := range j.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var css strings.Builder
+	for _, name := range names {
+		css.WriteString(name + ":" + j.Properties[name] + ";")
+	}
+	s, unrecognized := ParseStyle(css.String())
+	if len(unrecognized) > 0 {
+		return nil, fmt.Errorf("browser.Node: unrecognized style declaration %s:%s", unrecognized[0].Property, unrecognized[0].Value)
+	}
+	if len(j.Keyframes) > 0 {
+		if s.Animation.IsZero() {
+			return nil, fmt.Errorf("browser.Node: keyframes without an animation")
+		}
+		k, err := keyframesFromJSON(j.Keyframes)
+		if err != nil {
+			return nil, err
+		}
+		s.Animation.Keyframes = k
+	}
+
+	for selector, vj := range j.Variants {
+		v, err := styleFromJSON(vj)
+		if err != nil {
+			return nil, err
+		}
+		if !s.setVariant(selector, v) {
+			return nil, fmt.Errorf("browser.Node: unknown variant %q", selector)
+		}
+	}
+	for _, bj := range j.Breakpoints {
+		w, ok := parseSize(bj.MinWidth)
+		if !ok {
+			return nil, fmt.Errorf("browser.Node: invalid breakpoint min width %q", bj.MinWidth)
+		}
+		b := Breakpoint{MinWidth: w}
+		if bj.Style != nil {
+			bs, err := styleFromJSON(bj.Style)
+			if err != nil {
+				return nil, err
+			}
+			b.Style = *bs
+		}
+		s.Breakpoints = append(s.Breakpoints, b)
+	}
+
+	return &s, nil
+}
+
+// }}}
@@ -0,0 +1,126 @@
+package browser
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestJSON(t *testing.T) {
+	fade := NewKeyframes(
+		Keyframe{Offset: 0, Style: Style{Opacity: "0"}},
+		Keyframe{Offset: 1, Style: Style{Opacity: "1"}},
+	)
+	button := (&Node{Type: html.ElementNode, DataAtom: atom.Button}).
+		Class("primary").
+		PaddingPX(4).
+		BorderRadius(PX(4).Mul(2)).
+		SetVar("--accent", "teal").
+		Animation(Animation{Keyframes: fade, Duration: 200 * time.Millisecond, FillMode: AnimationFillModeBoth}).
+		HoverStyle(Style{Color: "gray"}).
+		DisabledStyle(Style{}).
+		Breakpoint(BreakpointMD, Style{Width: PG(50), Hover: &Style{Color: "black"}}).
+		OnClick(func(dom.Event) {})
+	button.Children = []*Node{{Type: html.TextNode, Data: "Save <now>"}}
+	canvas := &Node{Type: html.ElementNode, Data: "x-canvas", CanvasDraw: func(dom.CanvasRenderingContext2D) {}}
+	root := &Node{Type: html.ElementNode, DataAtom: atom.Div, Children: []*Node{button, canvas}}
+
+	var b bytes.Buffer
+	if err := EncodeJSON(&b, root); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"tag": "button"`, `"tag": "x-canvas"`, `"handlers": [`, `"canvas": true`, `":hover"`, `"text": "Save <now>"`} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("missing %s in\n%s", want, b.String())
+		}
+	}
+
+	decoded, err := DecodeJSON(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decoded.Children[0]
+	if got.DataAtom != atom.Button || got.Data != "" || decoded.Children[1].Data != "x-canvas" {
+		t.Errorf("tags: got %v %q, %q", got.DataAtom, got.Data, decoded.Children[1].Data)
+	}
+	if !got.Style.Equal(&button.Style) {
+		t.Errorf("style: got %s, want %s", got.Style.Val(), button.Style.Val())
+	}
+	if got.Handlers.Click == nil || got.Handlers.Input != nil || decoded.Children[1].CanvasDraw == nil {
+		t.Error("handlers: want placeholders for the encoded ones only")
+	}
+
+	var again bytes.Buffer
+	if err := EncodeJSON(&again, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != b.String() {
+		t.Errorf("encoding is not stable:\n%s\nthen\n%s", b.String(), again.String())
+	}
+
+	for _, bad := range []string{
+		`{"type":"comment"}`,
+		`{"type":"element","tag":"p","handlers":["scroll"]}`,
+		`{"type":"element","tag":"p","style":{"properties":{"colour":"red"}}}`,
+		`{"type":"element","tag":"p","style":{"variants":{":visited":{}}}}`,
+	} {
+		if _, err := DecodeJSON(strings.NewReader(bad)); err == nil {
+			t.Errorf("DecodeJSON(%s): want an error", bad)
+		}
+	}
+}
+
+// TestJSONKeyframes decodes a tree encoded by another process, so that
+// its keyframes are made from the JSON alone.
+func TestJSONKeyframes(t *testing.T) {
+	data, err := os.ReadFile("testdata/animation.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := DecodeJSON(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const name = "b-f9b1fe3e0a4ec177"
+	if got := n.Style.Val(); got != "animation:"+name+" 1s infinite;" {
+		t.Errorf("style: got %s", got)
+	}
+
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+	if err := m.Mount(n); err != nil {
+		t.Fatal(err)
+	}
+	want := "<head><style>@keyframes " + name + "{0%{opacity:1;transform:scale(1, 1);}" +
+		"50%{opacity:0.5;transform:scale(1.1, 1.1);}100%{opacity:1;transform:scale(1, 1);}}\n</style></head>"
+	if got := d.HeadElement().HTML(); got != want {
+		t.Errorf("stylesheet:\n got %s\nwant %s", got, want)
+	}
+
+	var again bytes.Buffer
+	if err := EncodeJSON(&again, n); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != string(data) {
+		t.Errorf("encoding is not stable:\n%s\nthen\n%s", data, again.String())
+	}
+
+	for _, bad := range []string{
+		`{"type":"element","tag":"p","style":{"keyframes":[{"offset":"0%"}]}}`,
+		`{"type":"element","tag":"p","style":{"properties":{"animation":"x 1s"},"keyframes":[{"offset":"50"}]}}`,
+		`{"type":"element","tag":"p","style":{"properties":{"animation":"x 1s"},"keyframes":[{"offset":"50%"},{"offset":"0%"}]}}`,
+		`{"type":"element","tag":"p","style":{"properties":{"animation":"x 1s"},"keyframes":[{"offset":"NaN%"}]}}`,
+	} {
+		if _, err := DecodeJSON(strings.NewReader(bad)); err == nil {
+			t.Errorf("DecodeJSON(%s): want an error", bad)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package browser
+
+import (
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestAttr(t *testing.T) {
+	base := (&Node{Type: html.ElementNode, DataAtom: atom.Div}).ID("a").Class("x")
+	n := &Node{Type: html.ElementNode, DataAtom: atom.Div, Attr: base.Attr}
+
+	n.ID("b").Role(RoleButton).TabIndex(0).AriaPressed(true).DataAttr("row", "3").Disabled(true)
+	if id, _ := base.GetAttr("id"); id != "a" {
+		t.Errorf("base id: got %q, want the shared attributes copied before writing", id)
+	}
+	want := [][2]string{
+		{"id", "b"}, {"class", "x"}, {"role", "button"}, {"tabindex", "0"},
+		{"aria-pressed", "true"}, {"data-row", "3"}, {"disabled", ""},
+	}
+	if len(n.Attr) != len(want) {
+		t.Fatalf("got %d attributes, want %d", len(n.Attr), len(want))
+	}
+	for i, w := range want {
+		if a := n.Attr[i]; a.Key != w[0] || a.Val != w[1] {
+			t.Errorf("attribute %d: got %s=%q, want %s=%q", i, a.Key, a.Val, w[0], w[1])
+		}
+	}
+
+	n.Disabled(false).RemoveAttr("class").RemoveAttr("missing")
+	if _, ok := n.GetAttr("disabled"); ok {
+		t.Error("disabled: got it, want it removed")
+	}
+	if _, ok := n.GetAttr("class"); ok {
+		t.Error("class: got it, want it removed")
+	}
+	if c, _ := base.GetAttr("class"); c != "x" {
+		t.Errorf("base class: got %q, want x", c)
+	}
+}
@@ -0,0 +1,259 @@
+package browser
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Query (e.g. Walk, Find, FindAll) {{{
+//
+// Find and FindAll match nodes by a CSS selector, without a DOM, e.g.,
+//
+//	save := view.Find("button#save")
+//	errors := view.FindAll("form .error")
+//
+// A selector is a list of compound selectors separated by spaces, each
+// matching a descendant of a node matching the previous one. A compound
+// selector is an optional tag name, or *, then any of #id, .class, [attr]
+// and [attr=value], where the value may be quoted. The classes are those
+// of the class attribute.
+
+// Walk calls f for n and its descendants, in document order, skipping
+// the descendants of a node for which f returns false.
+func (n *Node) Walk(f func(*Node) bool) {
+	if n == nil || !f(n) {
+		return
+	}
+	for _, c := range n.Children {
+		c.Walk(f)
+	}
+}
+
+// Find returns the first node of n and its descendants matching the
+// selector, or nil if there is none. It panics if the selector is
+// invalid, see ParseSelector.
+func (n *Node) Find(selector string) (found *Node) {
+	s := MustParseSelector(selector)
+	s.walk(n, func(m *Node) bool {
+		found = m
+		return false
+	})
+	return found
+}
+
+// FindAll returns the nodes of n and its descendants matching the
+// selector, in document order. It panics if the selector is invalid, see
+// ParseSelector.
+func (n *Node) FindAll(selector string) (found []*Node) {
+	s := MustParseSelector(selector)
+	s.walk(n, func(m *Node) bool {
+		found = append(found, m)
+		return true
+	})
+	return found
+}
+
+// A Selector is a parsed selector.
+type Selector struct {
+	compounds []compound
+}
+
+type compound struct {
+	tag     string // "" matches any
+	id      string
+	classes []string
+	attrs   []attrSelector
+}
+
+type attrSelector struct {
+	key, val string
+	hasVal   bool
+}
+
+// ParseSelector parses a selector, e.g., "ul.todo li[data-done]".
+func ParseSelector(selector string) (Selector, error) {
+	var s Selector
+	for _, f := range fields(selector) {
+		c, err := parseCompound(f)
+		if err != nil {
+			return Selector{}, fmt.Errorf("browser.ParseSelector: %q: %v", selector, err)
+		}
+		s.compounds = append(s.compounds, c)
+	}
+	if len(s.compounds) == 0 {
+		return Selector{}, fmt.Errorf("browser.ParseSelector: empty selector")
+	}
+
+	return s, nil
+}
+
+// MustParseSelector is ParseSelector, but panics if the selector is invalid.
+func MustParseSelector(selector string) Selector {
+	s, err := ParseSelector(selector)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func parseCompound(f string) (c compound, err error) {
+	name := func(i int) (string, int) {
+		j := i
+		for j < len(f) && (f[j] == '-' || f[j] == '_' || 'a' <= f[j]|0x20 && f[j]|0x20 <= 'z' || '0' <= f[j] && f[j] <= '9') {
+			j++
+		}
+		return f[i:j], j
+	}
+
+	i := 0
+	if strings.HasPrefix(f, "*") {
+		i = 1
+	} else {
+		c.tag, i = name(0)
+		c.tag = strings.ToLower(c.tag)
+	}
+	for i < len(f) {
+		var v string
+		switch f[i] {
+		case '#':
+			if v, i = name(i + 1); v == "" {
+				return c, fmt.Errorf("missing id")
+			}
+			c.id = v
+		case '.':
+			if v, i = name(i + 1); v == "" {
+				return c, fmt.Errorf("missing class")
+			}
+			c.classes = append(c.classes, v)
+		case '[':
+			end := strings.IndexByte(f[i:], ']')
+			if end < 0 {
+				return c, fmt.Errorf("unclosed [")
+			}
+			a, err := parseAttrSelector(f[i+1 : i+end])
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, a)
+			i += end + 1
+		default:
+			return c, fmt.Errorf("unexpected %q", f[i])
+		}
+	}
+
+	return c, nil
+}
+
+func parseAttrSelector(s string) (a attrSelector, err error) {
+	a.key, a.val, a.hasVal = strings.Cut(s, "=")
+	a.key = strings.ToLower(strings.TrimSpace(a.key))
+	if !validName(a.key) {
+		return a, fmt.Errorf("invalid attribute %q", a.key)
+	}
+	if !a.hasVal {
+		return a, nil
+	}
+
+	v := strings.TrimSpace(a.val)
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		v = v[1 : len(v)-1]
+	} else if strings.ContainsAny(v, `"'`) {
+		return a, fmt.Errorf("invalid attribute value %s", v)
+	}
+	a.val = v
+
+	return a, nil
+}
+
+// walk calls f for each node of n and its descendants which matches s,
+// in document order, until f returns false.
+func (s Selector) walk(n *Node, f func(*Node) bool) {
+	var (
+		ancestors []*Node
+		stop      bool
+		visit     func(*Node)
+	)
+	visit = func(n *Node) {
+		if s.Match(n, ancestors) && !f(n) {
+			stop = true
+			return
+		}
+		ancestors = append(ancestors, n)
+		for _, c := range n.Children {
+			if visit(c); stop {
+				return
+			}
+		}
+		ancestors = ancestors[:len(ancestors)-1]
+	}
+	if n != nil {
+		visit(n)
+	}
+}
+
+// Match reports whether n matches s, given its ancestors, from the root.
+func (s Selector) Match(n *Node, ancestors []*Node) bool {
+	last := len(s.compounds) - 1
+	if !s.compounds[last].match(n) {
+		return false
+	}
+
+	// match the rest against the closest ancestors which do
+	i := last - 1
+	for j := len(ancestors) - 1; i >= 0 && j >= 0; j-- {
+		if s.compounds[i].match(ancestors[j]) {
+			i--
+		}
+	}
+	return i < 0
+}
+
+func (c *compound) match(n *Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	if c.tag != "" {
+		tag := n.Data
+		if tag == "" {
+			tag = n.DataAtom.String()
+		}
+		if strings.ToLower(tag) != c.tag {
+			return false
+		}
+	}
+	if c.id != "" {
+		if id, ok := n.GetAttr("id"); !ok || id != c.id {
+			return false
+		}
+	}
+	if len(c.classes) > 0 {
+		class, _ := n.GetAttr("class")
+		have := strings.Fields(class)
+		for _, want := range c.classes {
+			if !contains(have, want) {
+				return false
+			}
+		}
+	}
+	for _, a := range c.attrs {
+		v, ok := n.GetAttr(a.key)
+		if !ok || a.hasVal && v != a.val {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(ss []string, s string) bool {
+	for _, t := range ss {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+// }}}
@@ -0,0 +1,71 @@
+package browser
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	view := MustParseHTML(`<form id="f">` +
+		`<div class="row error"><label for="name">Name</label><input id="name" required></div>` +
+		`<div class="row"><input id="email" type="email"></div>` +
+		`<p class="error">Fix the errors</p>` +
+		`<button id="save" data-kind="primary action">Save</button>` +
+		`</form>`)[0]
+
+	for _, c := range []struct {
+		selector string
+		want     []string // ids, or text of the first child
+	}{
+		{"button#save", []string{"save"}},
+		{"#save", []string{"save"}},
+		{".error", []string{"", "Fix the errors"}},
+		{"form .row input", []string{"name", "email"}},
+		{"div.row.error input", []string{"name"}},
+		{"input[required]", []string{"name"}},
+		{"[type=email]", []string{"email"}},
+		{`[data-kind="primary action"]`, []string{"save"}},
+		{"FORM", []string{"f"}},
+		{"p input", nil},
+		{"* label", []string{"Name"}},
+	} {
+		var got []string
+		for _, n := range view.FindAll(c.selector) {
+			id, _ := n.GetAttr("id")
+			if id == "" && len(n.Children) > 0 {
+				id = n.Children[0].Data
+			}
+			got = append(got, id)
+		}
+		if len(got) != len(c.want) {
+			t.Errorf("%s: got %q, want %q", c.selector, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%s: got %q, want %q", c.selector, got, c.want)
+				break
+			}
+		}
+	}
+
+	if n := view.Find(".row input"); n == nil || n != view.FindAll("#name")[0] {
+		t.Errorf("Find: got %v, want the first match", n)
+	}
+	if n := view.Find("table"); n != nil {
+		t.Errorf("Find: got %v, want nil", n)
+	}
+
+	var count int
+	div := MustParseSelector("div")
+	view.Walk(func(n *Node) bool {
+		count++
+		return !div.Match(n, nil) // skip the rows
+	})
+	if count != 7 {
+		t.Errorf("Walk: visited %d nodes, want 7", count)
+	}
+
+	for _, bad := range []string{"", "div >", "#", "a.", "[x", "[=y]", "a:hover"} {
+		if _, err := ParseSelector(bad); err == nil {
+			t.Errorf("ParseSelector(%q): want an error", bad)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package scene
+
+import "math"
+
+// Matrix is a 2D affine transformation, laid out as the arguments to the
+// canvas `transform` method:
+//
+//	[A C E]
+//	[B D F]
+//	[0 0 1]
+//
+// Build one from Identity; the zero Matrix maps every point to the origin.
+type Matrix struct {
+	A, B, C, D, E, F float64
+}
+
+// Identity is the identity transformation.
+var Identity = Matrix{A: 1, D: 1}
+
+// Mul returns the transformation which applies n and then m.
+func (m Matrix) Mul(n Matrix) Matrix {
+	return Matrix{
+		A: m.A*n.A + m.C*n.B,
+		B: m.B*n.A + m.D*n.B,
+		C: m.A*n.C + m.C*n.D,
+		D: m.B*n.C + m.D*n.D,
+		E: m.A*n.E + m.C*n.F + m.E,
+		F: m.B*n.E + m.D*n.F + m.F,
+	}
+}
+
+// Translate returns m followed by a translation, in m's coordinates.
+func (m Matrix) Translate(x, y float64) Matrix {
+	return m.Mul(Matrix{A: 1, D: 1, E: x, F: y})
+}
+
+// Rotate returns m followed by a rotation of rad radians, in m's coordinates.
+func (m Matrix) Rotate(rad float64) Matrix {
+	s, c := math.Sincos(rad)
+	return m.Mul(Matrix{A: c, B: s, C: -s, D: c})
+}
+
+// Scale returns m followed by a scaling, in m's coordinates.
+func (m Matrix) Scale(sx, sy float64) Matrix {
+	return m.Mul(Matrix{A: sx, D: sy})
+}
+
+// Apply maps the point (x, y) through m.
+func (m Matrix) Apply(x, y float64) (float64, float64) {
+	return m.A*x + m.C*y + m.E, m.B*x + m.D*y + m.F
+}
+
+// Invert returns the inverse of m, and false if m is singular.
+func (m Matrix) Invert() (Matrix, bool) {
+	det := m.A*m.D - m.B*m.C
+	if det == 0 {
+		return Matrix{}, false
+	}
+	return Matrix{
+		A: m.D / det,
+		B: -m.B / det,
+		C: -m.C / det,
+		D: m.A / det,
+		E: (m.C*m.F - m.D*m.E) / det,
+		F: (m.B*m.E - m.A*m.F) / det,
+	}, true
+}
@@ -0,0 +1,284 @@
+// Package scene describes canvas content as a retained tree of shapes.
+//
+// A Scene draws into a canvas *browser.Node (see Scene.Node) and
+// hit-tests mouse events on that canvas back to the shape under the
+// pointer, dispatching the browser.Event returned by the shape's Handler.
+// It replaces hand-written hit testing against the coordinates of
+// dom.Event.
+package scene
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Scene is the root of a tree of shapes drawn into a canvas of the
+// given Width and Height.
+type Scene struct {
+	Width, Height float64
+
+	// Background, if set, fills the canvas before drawing.
+	Background string
+
+	Root Group
+
+	mu    sync.Mutex
+	drawn *drawn // as last drawn, for the event handlers
+}
+
+// drawn is a copy of a Scene as it was drawn. The event handlers hit test
+// against it, as the application may change the Scene while they run.
+type drawn struct {
+	width, height float64
+	root          *Group
+
+	// orig maps each shape of root to the Scene's.
+	orig map[Shape]Shape
+}
+
+// Add adds shapes to the scene's root group.
+func (s *Scene) Add(shapes ...Shape) *Scene {
+	s.Root.Add(shapes...)
+	return s
+}
+
+// Hit describes the shapes under the pointer for a mouse event.
+type Hit struct {
+	// Shape is the top-most shape under the pointer.
+	Shape Shape
+
+	// Path is the chain of shapes from the scene's root group to Shape.
+	Path []Shape
+
+	// X and Y are the pointer's scene coordinates.
+	X, Y float64
+
+	// LocalX and LocalY are the pointer's coordinates in Shape's
+	// local coordinate system.
+	LocalX, LocalY float64
+
+	Event dom.Event
+}
+
+// A Handler returns the event to dispatch for a hit, or nil for none.
+type Handler func(h *Hit) browser.Event
+
+type eventKind int
+
+const (
+	click eventKind = iota
+	mouseDown
+	mouseUp
+	mouseMove
+)
+
+// Draw renders the scene; it is the CanvasDraw function of Scene.Node.
+func (s *Scene) Draw(ctx dom.CanvasRenderingContext2D) {
+	ctx.ClearRect(0, 0, s.Width, s.Height)
+	if s.Background != "" {
+		ctx.SetFillStyle(s.Background)
+		ctx.FillRect(0, 0, s.Width, s.Height)
+	}
+	drawShape(ctx, &s.Root)
+
+	d := &drawn{width: s.Width, height: s.Height, orig: make(map[Shape]Shape)}
+	d.root = s.Root.clone().(*Group)
+	d.pair(&s.Root, d.root)
+	s.mu.Lock()
+	s.drawn = d
+	s.mu.Unlock()
+}
+
+// pair records that c is the copy of the shape sh, as are their children.
+func (d *drawn) pair(sh, c Shape) {
+	d.orig[c] = sh
+	if g, ok := sh.(*Group); ok {
+		for i, x := range g.Children {
+			d.pair(x, c.(*Group).Children[i])
+		}
+	}
+}
+
+func drawShape(ctx dom.CanvasRenderingContext2D, sh Shape) {
+	b := sh.base()
+	if b.Hidden {
+		return
+	}
+
+	ctx.Save()
+	if t := b.Transform; t != nil {
+		ctx.Transform(t.A, t.B, t.C, t.D, t.E, t.F)
+	}
+	sh.draw(ctx)
+	ctx.Restore()
+}
+
+// HitTest returns the top-most shape at the scene coordinates (x, y),
+// or nil if there is none. Groups are never returned themselves, only
+// as part of Hit.Path.
+//
+// HitTest reads the shapes, so call it from the goroutine which changes
+// them; the canvas's handlers hit test a copy made when it is drawn.
+func (s *Scene) HitTest(x, y float64) *Hit {
+	path, lx, ly := hitTest(&s.Root, x, y)
+	if path == nil {
+		return nil
+	}
+
+	return &Hit{
+		Shape:  path[len(path)-1],
+		Path:   path,
+		X:      x,
+		Y:      y,
+		LocalX: lx,
+		LocalY: ly,
+	}
+}
+
+// hitTest returns the path to the top-most shape under (x, y), which are
+// in sh's parent's coordinates, along with the point in that shape's
+// local coordinates.
+func hitTest(sh Shape, x, y float64) (path []Shape, lx, ly float64) {
+	b := sh.base()
+	if b.Hidden {
+		return nil, 0, 0
+	}
+
+	if b.Transform != nil {
+		inv, ok := b.Transform.Invert()
+		if !ok { // collapsed to nothing; can't be hit
+			return nil, 0, 0
+		}
+		x, y = inv.Apply(x, y)
+	}
+
+	if g, ok := sh.(*Group); ok {
+		cs := g.byZ()
+		for i := len(cs) - 1; i >= 0; i-- {
+			if p, lx, ly := hitTest(cs[i], x, y); p != nil {
+				return append([]Shape{sh}, p...), lx, ly
+			}
+		}
+		return nil, 0, 0
+	}
+
+	if sh.contains(x, y) {
+		return []Shape{sh}, x, y
+	}
+
+	return nil, 0, 0
+}
+
+// Node returns a canvas element which draws the scene and dispatches
+// the events of its shapes' handlers.
+//
+// Mouse events bubble from the hit shape up through its groups; the
+// first shape with a handler for the event handles it. They are hit
+// tested against the scene as it was last drawn. The canvas listens only
+// to the events the scene's shapes have handlers for, so build the node
+// again after adding handlers.
+func (s *Scene) Node() *browser.Node {
+	n := &browser.Node{
+		Type:     html.ElementNode,
+		DataAtom: atom.Canvas,
+		Attr: []*html.Attribute{
+			&html.Attribute{Key: atom.Width.String(), Val: fmt.Sprintf("%g", s.Width)},
+			&html.Attribute{Key: atom.Height.String(), Val: fmt.Sprintf("%g", s.Height)},
+		},
+		CanvasDraw: s.Draw,
+	}
+
+	var handled [mouseMove + 1]bool
+	handlers(&s.Root, &handled)
+	if handled[click] {
+		n.OnClick(s.listener(click))
+	}
+	if handled[mouseDown] {
+		n.OnMouseDown(s.listener(mouseDown))
+	}
+	if handled[mouseUp] {
+		n.OnMouseUp(s.listener(mouseUp))
+	}
+	if handled[mouseMove] {
+		n.OnMouseMove(s.listener(mouseMove))
+	}
+	return n
+}
+
+// handlers marks the kinds of events sh, or its children, handle.
+func handlers(sh Shape, handled *[mouseMove + 1]bool) {
+	for k := range handled {
+		if sh.base().handler(eventKind(k)) != nil {
+			handled[k] = true
+		}
+	}
+	if g, ok := sh.(*Group); ok {
+		for _, c := range g.Children {
+			handlers(c, handled)
+		}
+	}
+}
+
+func (s *Scene) listener(k eventKind) dom.EventHandler {
+	return func(e dom.Event) {
+		s.mu.Lock()
+		d := s.drawn
+		s.mu.Unlock()
+		if d == nil {
+			return // not yet drawn
+		}
+
+		x, y := d.point(e)
+		path, lx, ly := hitTest(d.root, x, y)
+		if path == nil {
+			return
+		}
+		h := &Hit{
+			Shape:  d.orig[path[len(path)-1]],
+			Path:   make([]Shape, len(path)),
+			X:      x,
+			Y:      y,
+			LocalX: lx,
+			LocalY: ly,
+			Event:  e,
+		}
+		for i, sh := range path {
+			h.Path[i] = d.orig[sh]
+		}
+
+		for i := len(path) - 1; i >= 0; i-- {
+			f := path[i].base().handler(k)
+			if f == nil {
+				continue
+			}
+			if ev := f(h); ev != nil {
+				go browser.Dispatch(ev)
+			}
+			return
+		}
+	}
+}
+
+// point returns the scene coordinates of the pointer for an event on the
+// canvas: its client coordinates, relative to the canvas's box and scaled
+// by the canvas's size in CSS pixels. The canvas is assumed to have no
+// border or padding.
+func (d *drawn) point(e dom.Event) (x, y float64) {
+	x, y = float64(e.ClientX()), float64(e.ClientY())
+
+	b, ok := e.Target().(dom.Bounded)
+	if !ok {
+		return x, y
+	}
+	r := b.BoundingClientRect()
+	x, y = x-r.X, y-r.Y
+	if r.Width > 0 && r.Height > 0 {
+		x, y = x*d.width/r.Width, y*d.height/r.Height
+	}
+	return x, y
+}
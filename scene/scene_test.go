@@ -0,0 +1,103 @@
+package scene
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nlandolfi/browser"
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/dom/domtest"
+)
+
+func TestHitTest(t *testing.T) {
+	var s Scene
+	back := &Rect{Base: Base{ID: "back"}, X: 0, Y: 0, Width: 100, Height: 100}
+	front := &Rect{Base: Base{ID: "front", Z: 1}, X: 50, Y: 50, Width: 100, Height: 100}
+	turn := Identity.Translate(200, 0).Rotate(math.Pi / 2)
+	g := &Group{Base: Base{ID: "g", Transform: &turn}}
+	g.Add(&Circle{Base: Base{ID: "circle"}, X: 10, Y: 0, Radius: 5})
+	collapsed := Identity.Scale(0, 0) // the zero Matrix
+	flat := &Rect{Base: Base{ID: "flat", Z: 2, Transform: &collapsed}, X: 0, Y: 0, Width: 20, Height: 20}
+	s.Add(front, back, g, flat) // front is first, but Z puts it on top
+
+	cases := []struct {
+		x, y float64
+		want string
+	}{
+		{10, 10, "back"},
+		{75, 75, "front"},
+		{200, 10, "circle"}, // (10, 0) rotated a quarter turn, then translated
+		{210, 0, ""},
+		{500, 500, ""},
+		{5, 5, "back"}, // flat, on top, is scaled to nothing
+	}
+
+	for _, c := range cases {
+		h := s.HitTest(c.x, c.y)
+		got := ""
+		if h != nil {
+			got = h.Shape.base().ID
+		}
+		if got != c.want {
+			t.Errorf("HitTest(%g, %g): got %q, want %q", c.x, c.y, got, c.want)
+		}
+	}
+
+	if h := s.HitTest(200, 10); len(h.Path) != 3 || h.Path[1] != g {
+		t.Errorf("HitTest(200, 10): got path %v, want root, g, circle", h.Path)
+	}
+}
+
+func TestMount(t *testing.T) {
+	s := &Scene{Width: 640, Height: 480}
+	s.Add(&Rect{X: 0, Y: 0, Width: 10, Height: 10, Fill: "red"})
+
+	d := domtest.NewDocument()
+	m := &browser.Mounter{Document: d, Root: d.Body()}
+	if err := m.Mount(s.Node()); err != nil {
+		t.Fatal(err)
+	}
+
+	c := d.BodyElement().Children()[0].Canvas
+	if c == nil || c.Width != 640 || c.Height != 480 {
+		t.Fatalf("canvas: got %+v, want 640 by 480", c)
+	}
+}
+
+type clicked struct{ ID string }
+
+func TestClick(t *testing.T) {
+	s := &Scene{Width: 640, Height: 480}
+	s.Add(&Rect{
+		Base: Base{ID: "r", OnClick: func(h *Hit) browser.Event { return clicked{h.Shape.base().ID} }},
+		X:    100, Y: 100, Width: 10, Height: 10,
+	})
+
+	d := domtest.NewDocument()
+	m := &browser.Mounter{Document: d, Root: d.Body()}
+	if err := m.Mount(s.Node()); err != nil {
+		t.Fatal(err)
+	}
+
+	c := d.BodyElement().Children()[0]
+	if n := c.Listeners(dom.MouseMove); n != 0 {
+		t.Errorf("mousemove listeners: got %d, want none without handlers", n)
+	}
+
+	// hit tests use the scene as drawn
+	s.Root.Children[0].(*Rect).X = 300
+
+	// the canvas is at (10, 20) on the page, drawn at half its size
+	c.Box = dom.Rect{X: 10, Y: 20, Width: 320, Height: 240}
+	c.Dispatch(&domtest.Event{Type: dom.Click, X: 10 + 52, Y: 20 + 52})
+
+	select {
+	case e := <-browser.Events:
+		if e != (clicked{"r"}) {
+			t.Errorf("event: got %#v, want a click of r", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event dispatched")
+	}
+}
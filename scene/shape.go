@@ -0,0 +1,340 @@
+package scene
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/nlandolfi/browser/dom"
+)
+
+// A Shape is an element of a Scene. The implementations are the
+// types in this package, each of which embeds a Base.
+type Shape interface {
+	base() *Base
+
+	// draw renders the shape in local coordinates, i.e., with its
+	// Transform already applied to ctx.
+	draw(ctx dom.CanvasRenderingContext2D)
+
+	// contains reports whether the point, in local coordinates, is
+	// inside the shape.
+	contains(x, y float64) bool
+
+	// clone returns a copy of the shape, and of its children, which
+	// shares nothing the application may change.
+	clone() Shape
+}
+
+// Base holds the fields common to every Shape.
+type Base struct {
+	// ID is not used by the scene; it is for the application to identify
+	// shapes in handlers, e.g., which node of a diagram was clicked.
+	ID string
+
+	// Z orders siblings: higher Z draws later (on top) and is hit first.
+	// Siblings with equal Z keep their order in the Group.
+	Z int
+
+	// Transform maps the shape's local coordinates into its parent's;
+	// if nil, they are the same.
+	Transform *Matrix
+
+	// Hidden shapes are neither drawn nor hit.
+	Hidden bool
+
+	OnClick     Handler
+	OnMouseDown Handler
+	OnMouseUp   Handler
+	OnMouseMove Handler
+}
+
+func (b *Base) base() *Base { return b }
+
+// cloned returns a copy of b, with its own Transform.
+func (b *Base) cloned() Base {
+	c := *b
+	if c.Transform != nil {
+		m := *c.Transform
+		c.Transform = &m
+	}
+	return c
+}
+
+func (b *Base) handler(k eventKind) Handler {
+	switch k {
+	case click:
+		return b.OnClick
+	case mouseDown:
+		return b.OnMouseDown
+	case mouseUp:
+		return b.OnMouseUp
+	case mouseMove:
+		return b.OnMouseMove
+	}
+
+	panic(fmt.Sprintf("unknown eventKind: %#v", k))
+}
+
+// Group {{{
+
+// Group is a Shape that contains other shapes. A Group's Transform
+// applies to all of its children.
+type Group struct {
+	Base
+	Children []Shape
+}
+
+func (g *Group) Add(s ...Shape) *Group {
+	g.Children = append(g.Children, s...)
+	return g
+}
+
+// byZ returns the children in drawing order.
+func (g *Group) byZ() []Shape {
+	out := make([]Shape, len(g.Children))
+	copy(out, g.Children)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].base().Z < out[j].base().Z
+	})
+	return out
+}
+
+func (g *Group) draw(ctx dom.CanvasRenderingContext2D) {
+	for _, c := range g.byZ() {
+		drawShape(ctx, c)
+	}
+}
+
+// groups are hit through their children, see hitTest.
+func (g *Group) contains(x, y float64) bool { return false }
+
+func (g *Group) clone() Shape {
+	c := &Group{Base: g.Base.cloned(), Children: make([]Shape, len(g.Children))}
+	for i, s := range g.Children {
+		c.Children[i] = s.clone()
+	}
+	return c
+}
+
+// }}}
+
+// Rect {{{
+
+type Rect struct {
+	Base
+	X, Y, Width, Height float64
+
+	Fill      string
+	Stroke    string
+	LineWidth float64
+}
+
+func (r *Rect) draw(ctx dom.CanvasRenderingContext2D) {
+	if r.Fill != "" {
+		ctx.SetFillStyle(r.Fill)
+		ctx.FillRect(r.X, r.Y, r.Width, r.Height)
+	}
+	if r.Stroke != "" {
+		ctx.SetStrokeStyle(r.Stroke)
+		ctx.SetLineWidth(lineWidth(r.LineWidth))
+		ctx.StrokeRect(r.X, r.Y, r.Width, r.Height)
+	}
+}
+
+func (r *Rect) contains(x, y float64) bool {
+	return inBox(x, y, r.X, r.Y, r.Width, r.Height)
+}
+
+func (r *Rect) clone() Shape {
+	cp := *r
+	cp.Base = r.Base.cloned()
+	return &cp
+}
+
+// }}}
+
+// Circle {{{
+
+type Circle struct {
+	Base
+	X, Y, Radius float64
+
+	Fill      string
+	Stroke    string
+	LineWidth float64
+}
+
+func (c *Circle) draw(ctx dom.CanvasRenderingContext2D) {
+	ctx.BeginPath()
+	ctx.Arc(c.X, c.Y, c.Radius, 0, 2*math.Pi)
+	if c.Fill != "" {
+		ctx.SetFillStyle(c.Fill)
+		ctx.Fill()
+	}
+	if c.Stroke != "" {
+		ctx.SetStrokeStyle(c.Stroke)
+		ctx.SetLineWidth(lineWidth(c.LineWidth))
+		ctx.Stroke()
+	}
+}
+
+func (c *Circle) contains(x, y float64) bool {
+	return math.Hypot(x-c.X, y-c.Y) <= c.Radius
+}
+
+func (c *Circle) clone() Shape {
+	cp := *c
+	cp.Base = c.Base.cloned()
+	return &cp
+}
+
+// }}}
+
+// Line {{{
+
+// lineSlop is the minimum distance, in local units, at which a Line is hit;
+// otherwise thin lines would be nearly impossible to click.
+const lineSlop = 3
+
+type Line struct {
+	Base
+	X1, Y1, X2, Y2 float64
+
+	Stroke    string
+	LineWidth float64
+}
+
+func (l *Line) draw(ctx dom.CanvasRenderingContext2D) {
+	ctx.BeginPath()
+	ctx.MoveTo(l.X1, l.Y1)
+	ctx.LineTo(l.X2, l.Y2)
+	ctx.SetStrokeStyle(l.Stroke)
+	ctx.SetLineWidth(lineWidth(l.LineWidth))
+	ctx.Stroke()
+}
+
+func (l *Line) contains(x, y float64) bool {
+	return segmentDistance(x, y, l.X1, l.Y1, l.X2, l.Y2) <= math.Max(lineWidth(l.LineWidth)/2, lineSlop)
+}
+
+func (l *Line) clone() Shape {
+	cp := *l
+	cp.Base = l.Base.cloned()
+	return &cp
+}
+
+// }}}
+
+// Text {{{
+
+// Text is drawn with its alphabetic baseline starting at (X, Y).
+type Text struct {
+	Base
+	X, Y float64
+	Text string
+
+	Size   float64 // in px, defaults to 10
+	Family string  // defaults to sans-serif
+	Fill   string
+
+	// width is the measured width, set when drawn.
+	width float64
+}
+
+func (t *Text) size() float64 {
+	if t.Size == 0 {
+		return 10
+	}
+	return t.Size
+}
+
+func (t *Text) font() string {
+	f := t.Family
+	if f == "" {
+		f = "sans-serif"
+	}
+	return fmt.Sprintf("%gpx %s", t.size(), f)
+}
+
+func (t *Text) draw(ctx dom.CanvasRenderingContext2D) {
+	ctx.SetFont(t.font())
+	t.width = ctx.MeasureText(t.Text)
+	if t.Fill != "" {
+		ctx.SetFillStyle(t.Fill)
+	}
+	ctx.FillText(t.Text, t.X, t.Y)
+}
+
+func (t *Text) contains(x, y float64) bool {
+	w := t.width
+	if w == 0 { // not yet drawn, estimate
+		w = 0.6 * t.size() * float64(len([]rune(t.Text)))
+	}
+	return inBox(x, y, t.X, t.Y-t.size(), w, t.size())
+}
+
+func (t *Text) clone() Shape {
+	cp := *t
+	cp.Base = t.Base.cloned()
+	return &cp
+}
+
+// }}}
+
+// Image {{{
+
+// Image draws Source, an image-like element (e.g., an img or canvas),
+// scaled into the box.
+type Image struct {
+	Base
+	X, Y, Width, Height float64
+
+	Source dom.Element
+}
+
+func (i *Image) draw(ctx dom.CanvasRenderingContext2D) {
+	if i.Source == nil {
+		return
+	}
+	ctx.DrawImage(i.Source, i.X, i.Y, i.Width, i.Height)
+}
+
+func (i *Image) contains(x, y float64) bool {
+	return inBox(x, y, i.X, i.Y, i.Width, i.Height)
+}
+
+func (i *Image) clone() Shape {
+	cp := *i
+	cp.Base = i.Base.cloned()
+	return &cp
+}
+
+// }}}
+
+func lineWidth(w float64) float64 {
+	if w == 0 {
+		return 1
+	}
+	return w
+}
+
+func inBox(x, y, bx, by, bw, bh float64) bool {
+	if bw < 0 {
+		bx, bw = bx+bw, -bw
+	}
+	if bh < 0 {
+		by, bh = by+bh, -bh
+	}
+	return x >= bx && x <= bx+bw && y >= by && y <= by+bh
+}
+
+func segmentDistance(x, y, x1, y1, x2, y2 float64) float64 {
+	dx, dy := x2-x1, y2-y1
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return math.Hypot(x-x1, y-y1)
+	}
+	t := math.Max(0, math.Min(1, ((x-x1)*dx+(y-y1)*dy)/l2))
+	return math.Hypot(x-(x1+t*dx), y-(y1+t*dy))
+}
@@ -0,0 +1,7 @@
+//go:build !race
+
+package signals
+
+// claim reports, with -race, whether the calling goroutine may use
+// signals, see claim_race.go.
+func claim(depth int) bool { return true }
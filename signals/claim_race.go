@@ -0,0 +1,37 @@
+//go:build race
+
+package signals
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// owner is the goroutine using signals, while guard.depth > 0.
+var owner uint64
+
+// claim reports whether the calling goroutine may use signals: whether
+// no other is using them. It is called with guard held. As finding the
+// goroutine is slow, it is only built with -race.
+func claim(depth int) bool {
+	id := goroutine()
+	if depth > 0 && id != owner {
+		return false
+	}
+	owner = id
+	return true
+}
+
+// goroutine returns the id of the calling goroutine.
+func goroutine() uint64 {
+	var b [64]byte
+	s := b[:runtime.Stack(b[:], false)]
+	s = bytes.TrimPrefix(s, []byte("goroutine "))
+	s = s[:bytes.IndexByte(s, ' ')]
+	id, err := strconv.ParseUint(string(s), 10, 64)
+	if err != nil {
+		panic("signals: can't find the goroutine: " + err.Error())
+	}
+	return id
+}
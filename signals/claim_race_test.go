@@ -0,0 +1,38 @@
+//go:build race
+
+package signals
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser"
+)
+
+func TestOverlappingUse(t *testing.T) {
+	n := New(0)
+	building, release := make(chan struct{}), make(chan struct{})
+	v := NewView(func() *browser.Node {
+		close(building)
+		<-release
+		return text("")
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v.Node()
+	}()
+	<-building
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Get during another goroutine's build: want a panic")
+			}
+		}()
+		n.Get()
+	}()
+
+	close(release)
+	<-done
+}
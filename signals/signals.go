@@ -0,0 +1,337 @@
+// Package signals provides fine-grained reactive state for browser apps.
+//
+// A Signal holds a value. Computeds and Views derive from signals, and
+// Effects react to them; each records the signals (and computeds, and
+// views) it reads while running, and is invalidated when any of them
+// change.
+//
+// A View caches the *browser.Node tree it builds and returns the same
+// tree until something it read changes. The Mounter skips a subtree
+// whose *browser.Node is the one it mounted last time, so only the
+// views depending on a changed signal are rebuilt and reconciled:
+//
+//	count := signals.New(0)
+//	counter := signals.NewView(func() *browser.Node {
+//		return ui.Textf("%d", count.Get())
+//	})
+//
+//	func View(s *State) *browser.Node {
+//		return ui.VStack(header(s), counter.Node())
+//	}
+//
+// When a View is invalidated, Invalidate is called to request a
+// re-render, which by default dispatches a nil event. So signals
+// complement browser.Dispatch and the Mounter, rather than replace them.
+//
+// Signals share one dependency graph, so they may be used by one
+// goroutine at a time: the goroutine which handles events and mounts.
+// With a browser.Loop, DOM event handlers run on other goroutines, so
+// they should dispatch events for Handle to apply, rather than set
+// signals. Uses from different goroutines are synchronized, but uses
+// which overlap are not; with -race, they panic.
+package signals
+
+import (
+	"sync"
+
+	"github.com/nlandolfi/browser"
+)
+
+// Invalidate is called when a View needs to be rebuilt, at most once per
+// Set or Batch. Apps rendering with a browser.Loop can set it to
+// browser.RequestRender.
+var Invalidate = func() { go browser.Dispatch(nil) }
+
+// guard serializes the uses of signals, see use.
+var guard struct {
+	sync.Mutex
+	depth int // of nested uses
+}
+
+// use marks the start of a use of signals by the calling goroutine,
+// returning the func marking its end, as in
+//
+//	defer use()()
+//
+// Uses nest: the functions signals call (e.g., a View's build) use
+// signals too.
+func use() (done func()) {
+	guard.Lock()
+	ok := claim(guard.depth)
+	if ok {
+		guard.depth++
+	}
+	guard.Unlock()
+	if !ok {
+		panic("signals: used by two goroutines at once")
+	}
+	return unuse
+}
+
+func unuse() {
+	guard.Lock()
+	guard.depth--
+	guard.Unlock()
+}
+
+// vertex {{{
+
+// A vertex is a node of the dependency graph: signals are sources,
+// effects are observers, and computeds and views are both.
+type vertex struct {
+	deps map[*vertex]struct{} // what this observer read
+	subs map[*vertex]struct{} // who read this source
+
+	// invalidate is called when a dep changes; nil for signals.
+	invalidate func()
+}
+
+// running is the observer currently recording its reads, if any.
+var running *vertex
+
+// read records that the running observer, if any, read v.
+func (v *vertex) read() {
+	if running == nil || running == v {
+		return
+	}
+	if v.subs == nil {
+		v.subs = make(map[*vertex]struct{})
+	}
+	if running.deps == nil {
+		running.deps = make(map[*vertex]struct{})
+	}
+	v.subs[running] = struct{}{}
+	running.deps[v] = struct{}{}
+}
+
+// changed invalidates everything that read v.
+func (v *vertex) changed() {
+	batchDepth++
+	for s := range v.subs {
+		s.invalidate()
+	}
+	batchDepth--
+	flush()
+}
+
+// track runs f recording v's deps, after forgetting its old ones.
+func (v *vertex) track(f func()) {
+	v.untrack()
+	prev := running
+	running = v
+	defer func() { running = prev }()
+	f()
+}
+
+func (v *vertex) untrack() {
+	for d := range v.deps {
+		delete(d.subs, v)
+	}
+	v.deps = nil
+}
+
+// }}}
+
+// Signal {{{
+
+// A Signal is a reactive value. Use New to make one.
+type Signal[T any] struct {
+	v     vertex
+	value T
+}
+
+func New[T any](value T) *Signal[T] {
+	return &Signal[T]{value: value}
+}
+
+// Get returns the value, recording the read.
+func (s *Signal[T]) Get() T {
+	defer use()()
+	s.v.read()
+	return s.value
+}
+
+// Peek returns the value, without recording the read.
+func (s *Signal[T]) Peek() T {
+	defer use()()
+	return s.value
+}
+
+// Set sets the value, invalidating everything that read it.
+//
+// Signals do not compare values, so Set always invalidates.
+func (s *Signal[T]) Set(value T) {
+	defer use()()
+	s.value = value
+	s.v.changed()
+}
+
+// Update sets the value to f of the current value.
+func (s *Signal[T]) Update(f func(T) T) {
+	defer use()()
+	s.Set(f(s.value))
+}
+
+// }}}
+
+// Computed {{{
+
+// A Computed is a value derived from signals (or other computeds).
+// It is computed lazily, and cached until its dependencies change.
+type Computed[T any] struct {
+	v     vertex
+	f     func() T
+	value T
+	valid bool
+}
+
+func NewComputed[T any](f func() T) *Computed[T] {
+	c := &Computed[T]{f: f}
+	c.v.invalidate = func() {
+		if !c.valid {
+			return
+		}
+		c.valid = false
+		for s := range c.v.subs {
+			s.invalidate()
+		}
+	}
+	return c
+}
+
+// Get returns the value, recomputing it if needed, and records the read.
+func (c *Computed[T]) Get() T {
+	defer use()()
+	c.v.read()
+	if !c.valid {
+		c.v.track(func() { c.value = c.f() })
+		c.valid = true
+	}
+	return c.value
+}
+
+// }}}
+
+// Effect {{{
+
+// An Effect is a function which is re-run whenever what it read changes.
+type Effect struct {
+	v        vertex
+	f        func()
+	disposed bool
+}
+
+// NewEffect runs f, and then re-runs it whenever a value it read changes.
+func NewEffect(f func()) *Effect {
+	defer use()()
+	e := &Effect{f: f}
+	e.v.invalidate = func() { schedule(e) }
+	e.run()
+	return e
+}
+
+func (e *Effect) run() {
+	if e.disposed {
+		return
+	}
+	e.v.track(e.f)
+}
+
+// Dispose stops the effect from running again.
+func (e *Effect) Dispose() {
+	defer use()()
+	e.disposed = true
+	e.v.untrack()
+}
+
+var (
+	batchDepth int
+	pending    []*Effect
+
+	// render is set when a view is invalidated, and cleared when
+	// flush calls Invalidate, so that a Set or Batch requests one render.
+	render bool
+)
+
+func schedule(e *Effect) {
+	for _, p := range pending {
+		if p == e {
+			return
+		}
+	}
+	pending = append(pending, e)
+}
+
+func flush() {
+	if batchDepth > 0 {
+		return
+	}
+	for len(pending) > 0 {
+		e := pending[0]
+		pending = pending[1:]
+		e.run()
+	}
+	if render {
+		render = false
+		Invalidate()
+	}
+}
+
+// Batch runs f, deferring effects until it returns, so that an
+// effect depending on several signals set by f runs only once.
+func Batch(f func()) {
+	defer use()()
+	batchDepth++
+	defer func() {
+		batchDepth--
+		flush()
+	}()
+	f()
+}
+
+// }}}
+
+// View {{{
+
+// A View caches the *browser.Node tree returned by its build function,
+// rebuilding it only when something read by build changes.
+//
+// Views may be nested: a view which calls another's Node is rebuilt
+// when the inner view is, and then gets the inner view's new tree.
+type View struct {
+	v     vertex
+	build func() *browser.Node
+	last  *browser.Node
+	dirty bool
+}
+
+func NewView(build func() *browser.Node) *View {
+	v := &View{build: build, dirty: true}
+	v.v.invalidate = func() {
+		if v.dirty {
+			return
+		}
+		v.dirty = true
+		for s := range v.v.subs {
+			s.invalidate()
+		}
+		render = true
+	}
+	return v
+}
+
+// Node returns the view's tree, rebuilding it if needed.
+//
+// It returns the same *browser.Node until the view is invalidated,
+// so the tree must not be mutated by the caller.
+func (v *View) Node() *browser.Node {
+	defer use()()
+	v.v.read()
+	if v.dirty {
+		v.v.track(func() { v.last = v.build() })
+		v.dirty = false
+	}
+	return v.last
+}
+
+// }}}
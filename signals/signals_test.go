@@ -0,0 +1,87 @@
+package signals
+
+import (
+	"testing"
+
+	"github.com/nlandolfi/browser"
+	"golang.org/x/net/html"
+)
+
+func text(s string) *browser.Node {
+	return &browser.Node{Type: html.TextNode, Data: s}
+}
+
+func TestView(t *testing.T) {
+	renders := 0
+	old := Invalidate
+	t.Cleanup(func() { Invalidate = old })
+	Invalidate = func() { renders++ }
+
+	a, b := New("a"), New("b")
+	builds := 0
+	inner := NewView(func() *browser.Node { builds++; return text(b.Get()) })
+	outer := NewView(func() *browser.Node {
+		return &browser.Node{Type: html.ElementNode, Data: a.Get(), Children: []*browser.Node{inner.Node()}}
+	})
+
+	first := outer.Node()
+	if outer.Node() != first {
+		t.Fatal("unchanged view was rebuilt")
+	}
+
+	a.Set("A")
+	second := outer.Node()
+	if second == first || second.Children[0] != first.Children[0] || builds != 1 {
+		t.Fatalf("after setting a: want a new outer tree with the same inner tree (builds %d)", builds)
+	}
+
+	b.Set("B")
+	b.Set("BB")
+	if renders != 2 {
+		t.Fatalf("got %d render requests, want 2", renders)
+	}
+	if got := outer.Node().Children[0].Data; got != "BB" {
+		t.Fatalf("inner view: got %q, want %q", got, "BB")
+	}
+}
+
+func TestEffect(t *testing.T) {
+	x, y := New(1), New(2)
+	sum := NewComputed(func() int { return x.Get() + y.Get() })
+
+	var got []int
+	e := NewEffect(func() { got = append(got, sum.Get()) })
+
+	Batch(func() {
+		x.Set(10)
+		y.Set(20)
+	})
+	e.Dispose()
+	x.Set(100)
+
+	if len(got) != 2 || got[0] != 3 || got[1] != 30 {
+		t.Fatalf("effect saw %v, want [3 30]", got)
+	}
+}
+
+func TestDroppedView(t *testing.T) {
+	renders := 0
+	old := Invalidate
+	t.Cleanup(func() { Invalidate = old })
+	Invalidate = func() { renders++ }
+
+	a, b := New("a"), New("b")
+	va := NewView(func() *browser.Node { return text(a.Get()) })
+	vb := NewView(func() *browser.Node { return text(b.Get()) })
+	va.Node()
+	vb.Node()
+
+	a.Set("A") // va is invalidated, and then no longer rendered
+	b.Set("B")
+	if renders != 2 {
+		t.Fatalf("got %d render requests, want 2", renders)
+	}
+	if got := vb.Node().Data; got != "B" {
+		t.Fatalf("view: got %q, want %q", got, "B")
+	}
+}
@@ -0,0 +1,282 @@
+package browser
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The Style struct, its enums, Val, and the builders for its properties
+// are generated from the property table in internal/stylegen; the types
+// here are the hand-written, composite, property values.
+
+//go:generate go run ./internal/stylegen
+
+type UnitType int
+
+const (
+	UnitUnset UnitType = iota
+	UnitDefault
+	UnitEM
+	UnitPC
+	UnitPG
+	UnitPT
+	UnitPX
+	UnitVH
+	UnitVW
+	UnitREM
+	UnitFR
+)
+
+func (t UnitType) String() string {
+	switch t {
+	case UnitUnset, UnitDefault:
+		return ""
+	case UnitEM:
+		return "em"
+	case UnitPC:
+		return "pc"
+	case UnitPG:
+		return "%"
+	case UnitPT:
+		return "pt"
+	case UnitPX:
+		return "px"
+	case UnitVH:
+		return "vh"
+	case UnitVW:
+		return "vw"
+	case UnitREM:
+		return "rem"
+	case UnitFR:
+		return "fr"
+	}
+
+	panic(fmt.Sprintf("unknown UnitType: %#v", t))
+}
+
+type Size struct {
+	Value          float64
+	Unit           UnitType
+	StringOverride string // Use for calcs and vars
+}
+
+func (s *Size) IsZero() bool {
+	return s.Value == 0 && s.Unit == UnitUnset && s.StringOverride == ""
+}
+
+func (s *Size) String() string {
+	if s.StringOverride != "" {
+		return s.StringOverride
+	} else {
+		return fmt.Sprintf("%f%s", s.Value, s.Unit)
+	}
+}
+
+// Size Expressions {{{
+//
+// Sizes are combined into calc(), min(), max() and clamp() expressions
+// kept in the StringOverride, so that a Size stays comparable, e.g.,
+//
+//	browser.PG(100).Sub(browser.PX(20))            // calc(100% - 20px)
+//	browser.MaxSize(browser.REM(2), browser.VW(5)) // max(2rem, 5vw)
+
+func PX(v float64) Size  { return Size{Value: v, Unit: UnitPX} }
+func EM(v float64) Size  { return Size{Value: v, Unit: UnitEM} }
+func REM(v float64) Size { return Size{Value: v, Unit: UnitREM} }
+func PG(v float64) Size  { return Size{Value: v, Unit: UnitPG} }
+func VH(v float64) Size  { return Size{Value: v, Unit: UnitVH} }
+func VW(v float64) Size  { return Size{Value: v, Unit: UnitVW} }
+
+func (s Size) Add(t Size) Size    { return calc(s.operand() + " + " + t.operand()) }
+func (s Size) Sub(t Size) Size    { return calc(s.operand() + " - " + t.operand()) }
+func (s Size) Mul(f float64) Size { return calc(s.operand() + " * " + formatNumber(f)) }
+func (s Size) Div(f float64) Size { return calc(s.operand() + " / " + formatNumber(f)) }
+func MinSize(ss ...Size) Size     { return Size{StringOverride: sizeFunc("min", ss)} }
+func MaxSize(ss ...Size) Size     { return Size{StringOverride: sizeFunc("max", ss)} }
+func ClampSize(min, val, max Size) Size {
+	return Size{StringOverride: sizeFunc("clamp", []Size{min, val, max})}
+}
+
+func calc(expr string) Size { return Size{StringOverride: "calc(" + expr + ")"} }
+
+// operand formats s as an operand of a calc expression. The calc of a
+// nested expression is dropped, leaving its parentheses.
+func (s Size) operand() string {
+	if strings.HasPrefix(s.StringOverride, "calc(") {
+		return strings.TrimPrefix(s.StringOverride, "calc")
+	}
+	return s.value()
+}
+
+// value formats s as a CSS value, as String does but with the shortest
+// number, e.g., "4px" rather than "4.000000px".
+func (s Size) value() string {
+	if s.StringOverride != "" {
+		return s.StringOverride
+	}
+
+	return formatNumber(s.Value) + s.Unit.String()
+}
+
+func sizeFunc(name string, ss []Size) string {
+	args := make([]string, len(ss))
+	for i, s := range ss {
+		args[i] = s.operand()
+	}
+
+	return name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func formatNumber(f float64) string { return strconv.FormatFloat(f, 'f', -1, 64) }
+
+// }}}
+
+type Border struct {
+	Width Size
+	Type  BorderType
+	Color string
+}
+
+func (b *Border) String() string {
+	if b.Type == BorderNone {
+		return b.Type.String()
+	}
+	return fmt.Sprintf("%s %s %s", &b.Width, b.Type, b.Color)
+}
+
+type BoxShadow struct {
+	HOffset, VOffset, Blur, Spread Size
+	Color                          string
+}
+
+func (b *BoxShadow) IsZero() bool {
+	return b.HOffset == Size{} && b.VOffset == Size{} && b.Blur == Size{} && b.Spread == Size{} && b.Color == ""
+}
+
+func (b *BoxShadow) Encode(w io.Writer) {
+	fmt.Fprintf(w, "%s %s %s %s %s", &b.HOffset, &b.VOffset, &b.Blur, &b.Spread, b.Color)
+}
+
+func (b *BoxShadow) String() string {
+	var buf bytes.Buffer
+	b.Encode(&buf)
+	return buf.String()
+}
+
+type Outline struct {
+	Width Size
+	Type  OutlineType
+	Color string
+}
+
+func (o *Outline) IsZero() bool {
+	return o.Width.IsZero() && o.Type == OutlineUnset && o.Color == ""
+}
+
+func (o *Outline) String() string {
+	if o.Type == OutlineNone {
+		return o.Type.String()
+	}
+
+	return fmt.Sprintf("%s %s %s", &o.Width, o.Type, o.Color)
+}
+
+// Custom Properties {{{
+
+// A Var is a reference to a custom property, by its name, e.g.,
+//
+//	n.SetVar("--accent", "#3b82f6")
+//	child.Color(browser.Var("--accent").String())
+//	child.Padding(browser.Var("--space").Size())
+type Var string
+
+// String returns the var() expression, usable wherever a string value is.
+func (v Var) String() string { return "var(" + string(v) + ")" }
+
+// Or returns the var() expression with a fallback value.
+func (v Var) Or(fallback string) string { return "var(" + string(v) + ", " + fallback + ")" }
+
+// Size returns the var() expression as a Size.
+func (v Var) Size() Size { return Size{StringOverride: v.String()} }
+
+// SetVar sets the custom property, whose name must start with "--".
+//
+// Styles are often copied from a shared base style, so SetVar copies
+// Style.Vars rather than write to a map another node may hold.
+func (n *Node) SetVar(name, value string) *Node {
+	if !strings.HasPrefix(name, "--") {
+		panic(fmt.Sprintf("browser: custom property name must start with --: %q", name))
+	}
+
+	vars := make(map[string]string, len(n.Style.Vars)+1)
+	for k, v := range n.Style.Vars {
+		vars[k] = v
+	}
+	vars[name] = value
+	n.Style.Vars = vars
+	return n
+}
+
+// encodeVars writes the custom properties, sorted by name.
+func (s *Style) encodeVars(w io.Writer) {
+	if len(s.Vars) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(s.Vars))
+	for k := range s.Vars {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		fmt.Fprintf(w, "%s:%s;", k, s.Vars[k])
+	}
+}
+
+// mergeVars returns a copy of a, overridden by b.
+func mergeVars(a, b map[string]string) map[string]string {
+	if len(b) == 0 {
+		return a
+	}
+
+	vs := make(map[string]string, len(a)+len(b))
+	for k, v := range a {
+		vs[k] = v
+	}
+	for k, v := range b {
+		vs[k] = v
+	}
+	return vs
+}
+
+func varsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
+
+// }}}
+
+// Style Helpers {{{
+
+func (n *Node) FlexCenter() *Node {
+	return n.Display(DisplayFlex).JustifyContentCenter().AlignItemsCenter()
+}
+
+func (n *Node) Pointer() *Node { return n.Cursor(CursorPointer) }
+
+// MergeStyle overlays s on n's style, see Style.Merge, e.g., to let a
+// caller override the style of a component.
+func (n *Node) MergeStyle(s Style) *Node { n.Style.Merge(s); return n }
+
+// }}}
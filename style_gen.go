@@ -0,0 +1,3186 @@
+// Code generated by internal/stylegen; DO NOT EDIT.
+
+package browser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Enums {{{
+
+type AlignItemsType int
+
+const (
+	AlignItemsUnset AlignItemsType = iota
+	AlignItemsCenter
+	AlignItemsFlexStart
+	AlignItemsFlexEnd
+	AlignItemsStretch
+	AlignItemsBaseline
+	AlignItemsStart
+	AlignItemsEnd
+)
+
+func (t AlignItemsType) String() string {
+	switch t {
+	case AlignItemsUnset:
+		return ""
+	case AlignItemsCenter:
+		return "center"
+	case AlignItemsFlexStart:
+		return "flex-start"
+	case AlignItemsFlexEnd:
+		return "flex-end"
+	case AlignItemsStretch:
+		return "stretch"
+	case AlignItemsBaseline:
+		return "baseline"
+	case AlignItemsStart:
+		return "start"
+	case AlignItemsEnd:
+		return "end"
+	}
+
+	panic(fmt.Sprintf("unknown AlignItemsType: %#v", t))
+}
+
+func parseAlignItemsType(s string) (AlignItemsType, bool) {
+	switch s {
+	case "center":
+		return AlignItemsCenter, true
+	case "flex-start":
+		return AlignItemsFlexStart, true
+	case "flex-end":
+		return AlignItemsFlexEnd, true
+	case "stretch":
+		return AlignItemsStretch, true
+	case "baseline":
+		return AlignItemsBaseline, true
+	case "start":
+		return AlignItemsStart, true
+	case "end":
+		return AlignItemsEnd, true
+	}
+
+	return AlignItemsUnset, false
+}
+
+type AlignSelfType int
+
+const (
+	AlignSelfUnset AlignSelfType = iota
+	AlignSelfAuto
+	AlignSelfCenter
+	AlignSelfFlexStart
+	AlignSelfFlexEnd
+	AlignSelfStretch
+	AlignSelfBaseline
+	AlignSelfStart
+	AlignSelfEnd
+)
+
+func (t AlignSelfType) String() string {
+	switch t {
+	case AlignSelfUnset:
+		return ""
+	case AlignSelfAuto:
+		return "auto"
+	case AlignSelfCenter:
+		return "center"
+	case AlignSelfFlexStart:
+		return "flex-start"
+	case AlignSelfFlexEnd:
+		return "flex-end"
+	case AlignSelfStretch:
+		return "stretch"
+	case AlignSelfBaseline:
+		return "baseline"
+	case AlignSelfStart:
+		return "start"
+	case AlignSelfEnd:
+		return "end"
+	}
+
+	panic(fmt.Sprintf("unknown AlignSelfType: %#v", t))
+}
+
+func parseAlignSelfType(s string) (AlignSelfType, bool) {
+	switch s {
+	case "auto":
+		return AlignSelfAuto, true
+	case "center":
+		return AlignSelfCenter, true
+	case "flex-start":
+		return AlignSelfFlexStart, true
+	case "flex-end":
+		return AlignSelfFlexEnd, true
+	case "stretch":
+		return AlignSelfStretch, true
+	case "baseline":
+		return AlignSelfBaseline, true
+	case "start":
+		return AlignSelfStart, true
+	case "end":
+		return AlignSelfEnd, true
+	}
+
+	return AlignSelfUnset, false
+}
+
+type AnimationDirectionType int
+
+const (
+	AnimationDirectionUnset AnimationDirectionType = iota
+	AnimationDirectionNormal
+	AnimationDirectionReverse
+	AnimationDirectionAlternate
+	AnimationDirectionAlternateReverse
+)
+
+func (t AnimationDirectionType) String() string {
+	switch t {
+	case AnimationDirectionUnset:
+		return ""
+	case AnimationDirectionNormal:
+		return "normal"
+	case AnimationDirectionReverse:
+		return "reverse"
+	case AnimationDirectionAlternate:
+		return "alternate"
+	case AnimationDirectionAlternateReverse:
+		return "alternate-reverse"
+	}
+
+	panic(fmt.Sprintf("unknown AnimationDirectionType: %#v", t))
+}
+
+func parseAnimationDirectionType(s string) (AnimationDirectionType, bool) {
+	switch s {
+	case "normal":
+		return AnimationDirectionNormal, true
+	case "reverse":
+		return AnimationDirectionReverse, true
+	case "alternate":
+		return AnimationDirectionAlternate, true
+	case "alternate-reverse":
+		return AnimationDirectionAlternateReverse, true
+	}
+
+	return AnimationDirectionUnset, false
+}
+
+type AnimationFillModeType int
+
+const (
+	AnimationFillModeUnset AnimationFillModeType = iota
+	AnimationFillModeNone
+	AnimationFillModeForwards
+	AnimationFillModeBackwards
+	AnimationFillModeBoth
+)
+
+func (t AnimationFillModeType) String() string {
+	switch t {
+	case AnimationFillModeUnset:
+		return ""
+	case AnimationFillModeNone:
+		return "none"
+	case AnimationFillModeForwards:
+		return "forwards"
+	case AnimationFillModeBackwards:
+		return "backwards"
+	case AnimationFillModeBoth:
+		return "both"
+	}
+
+	panic(fmt.Sprintf("unknown AnimationFillModeType: %#v", t))
+}
+
+func parseAnimationFillModeType(s string) (AnimationFillModeType, bool) {
+	switch s {
+	case "none":
+		return AnimationFillModeNone, true
+	case "forwards":
+		return AnimationFillModeForwards, true
+	case "backwards":
+		return AnimationFillModeBackwards, true
+	case "both":
+		return AnimationFillModeBoth, true
+	}
+
+	return AnimationFillModeUnset, false
+}
+
+type BorderType int
+
+const (
+	BorderUnset BorderType = iota
+	BorderNone
+	BorderSolid
+	BorderDashed
+	BorderDotted
+	BorderDouble
+)
+
+func (t BorderType) String() string {
+	switch t {
+	case BorderUnset:
+		return ""
+	case BorderNone:
+		return "none"
+	case BorderSolid:
+		return "solid"
+	case BorderDashed:
+		return "dashed"
+	case BorderDotted:
+		return "dotted"
+	case BorderDouble:
+		return "double"
+	}
+
+	panic(fmt.Sprintf("unknown BorderType: %#v", t))
+}
+
+func parseBorderType(s string) (BorderType, bool) {
+	switch s {
+	case "none":
+		return BorderNone, true
+	case "solid":
+		return BorderSolid, true
+	case "dashed":
+		return BorderDashed, true
+	case "dotted":
+		return BorderDotted, true
+	case "double":
+		return BorderDouble, true
+	}
+
+	return BorderUnset, false
+}
+
+type BoxSizingType int
+
+const (
+	BoxSizingUnset BoxSizingType = iota
+	BoxSizingBorderBox
+	BoxSizingContentBox
+)
+
+func (t BoxSizingType) String() string {
+	switch t {
+	case BoxSizingUnset:
+		return ""
+	case BoxSizingBorderBox:
+		return "border-box"
+	case BoxSizingContentBox:
+		return "content-box"
+	}
+
+	panic(fmt.Sprintf("unknown BoxSizingType: %#v", t))
+}
+
+func parseBoxSizingType(s string) (BoxSizingType, bool) {
+	switch s {
+	case "border-box":
+		return BoxSizingBorderBox, true
+	case "content-box":
+		return BoxSizingContentBox, true
+	}
+
+	return BoxSizingUnset, false
+}
+
+type CursorType int
+
+const (
+	CursorUnset CursorType = iota
+	CursorDefault
+	CursorPointer
+	CursorMove
+	CursorNWSEResize
+	CursorEWResize
+	CursorNSResize
+	CursorText
+	CursorNotAllowed
+	CursorGrab
+	CursorGrabbing
+	CursorCrosshair
+	CursorWait
+)
+
+func (t CursorType) String() string {
+	switch t {
+	case CursorUnset:
+		return ""
+	case CursorDefault:
+		return "default"
+	case CursorPointer:
+		return "pointer"
+	case CursorMove:
+		return "move"
+	case CursorNWSEResize:
+		return "nwse-resize"
+	case CursorEWResize:
+		return "ew-resize"
+	case CursorNSResize:
+		return "ns-resize"
+	case CursorText:
+		return "text"
+	case CursorNotAllowed:
+		return "not-allowed"
+	case CursorGrab:
+		return "grab"
+	case CursorGrabbing:
+		return "grabbing"
+	case CursorCrosshair:
+		return "crosshair"
+	case CursorWait:
+		return "wait"
+	}
+
+	panic(fmt.Sprintf("unknown CursorType: %#v", t))
+}
+
+func parseCursorType(s string) (CursorType, bool) {
+	switch s {
+	case "default":
+		return CursorDefault, true
+	case "pointer":
+		return CursorPointer, true
+	case "move":
+		return CursorMove, true
+	case "nwse-resize":
+		return CursorNWSEResize, true
+	case "ew-resize":
+		return CursorEWResize, true
+	case "ns-resize":
+		return CursorNSResize, true
+	case "text":
+		return CursorText, true
+	case "not-allowed":
+		return CursorNotAllowed, true
+	case "grab":
+		return CursorGrab, true
+	case "grabbing":
+		return CursorGrabbing, true
+	case "crosshair":
+		return CursorCrosshair, true
+	case "wait":
+		return CursorWait, true
+	}
+
+	return CursorUnset, false
+}
+
+type DisplayType int
+
+const (
+	DisplayUnset DisplayType = iota
+	DisplayNone
+	DisplayFlex
+	DisplayGrid
+	DisplayBlock
+	DisplayInline
+	DisplayInlineBlock
+	DisplayInlineFlex
+	DisplayInlineGrid
+	DisplayContents
+)
+
+func (t DisplayType) String() string {
+	switch t {
+	case DisplayUnset:
+		return ""
+	case DisplayNone:
+		return "none"
+	case DisplayFlex:
+		return "flex"
+	case DisplayGrid:
+		return "grid"
+	case DisplayBlock:
+		return "block"
+	case DisplayInline:
+		return "inline"
+	case DisplayInlineBlock:
+		return "inline-block"
+	case DisplayInlineFlex:
+		return "inline-flex"
+	case DisplayInlineGrid:
+		return "inline-grid"
+	case DisplayContents:
+		return "contents"
+	}
+
+	panic(fmt.Sprintf("unknown DisplayType: %#v", t))
+}
+
+func parseDisplayType(s string) (DisplayType, bool) {
+	switch s {
+	case "none":
+		return DisplayNone, true
+	case "flex":
+		return DisplayFlex, true
+	case "grid":
+		return DisplayGrid, true
+	case "block":
+		return DisplayBlock, true
+	case "inline":
+		return DisplayInline, true
+	case "inline-block":
+		return DisplayInlineBlock, true
+	case "inline-flex":
+		return DisplayInlineFlex, true
+	case "inline-grid":
+		return DisplayInlineGrid, true
+	case "contents":
+		return DisplayContents, true
+	}
+
+	return DisplayUnset, false
+}
+
+type FlexDirectionType int
+
+const (
+	FlexDirectionUnset FlexDirectionType = iota
+	FlexDirectionRow
+	FlexDirectionColumn
+	FlexDirectionRowReverse
+	FlexDirectionColumnReverse
+)
+
+func (t FlexDirectionType) String() string {
+	switch t {
+	case FlexDirectionUnset:
+		return ""
+	case FlexDirectionRow:
+		return "row"
+	case FlexDirectionColumn:
+		return "column"
+	case FlexDirectionRowReverse:
+		return "row-reverse"
+	case FlexDirectionColumnReverse:
+		return "column-reverse"
+	}
+
+	panic(fmt.Sprintf("unknown FlexDirectionType: %#v", t))
+}
+
+func parseFlexDirectionType(s string) (FlexDirectionType, bool) {
+	switch s {
+	case "row":
+		return FlexDirectionRow, true
+	case "column":
+		return FlexDirectionColumn, true
+	case "row-reverse":
+		return FlexDirectionRowReverse, true
+	case "column-reverse":
+		return FlexDirectionColumnReverse, true
+	}
+
+	return FlexDirectionUnset, false
+}
+
+type FlexWrapType int
+
+const (
+	FlexWrapUnset FlexWrapType = iota
+	FlexWrapWrap
+	FlexWrapNoWrap
+	FlexWrapWrapReverse
+)
+
+func (t FlexWrapType) String() string {
+	switch t {
+	case FlexWrapUnset:
+		return ""
+	case FlexWrapWrap:
+		return "wrap"
+	case FlexWrapNoWrap:
+		return "nowrap"
+	case FlexWrapWrapReverse:
+		return "wrap-reverse"
+	}
+
+	panic(fmt.Sprintf("unknown FlexWrapType: %#v", t))
+}
+
+func parseFlexWrapType(s string) (FlexWrapType, bool) {
+	switch s {
+	case "wrap":
+		return FlexWrapWrap, true
+	case "nowrap":
+		return FlexWrapNoWrap, true
+	case "wrap-reverse":
+		return FlexWrapWrapReverse, true
+	}
+
+	return FlexWrapUnset, false
+}
+
+type FontStyleType int
+
+const (
+	FontStyleUnset FontStyleType = iota
+	FontStyleNormal
+	FontStyleItalic
+	FontStyleOblique
+)
+
+func (t FontStyleType) String() string {
+	switch t {
+	case FontStyleUnset:
+		return ""
+	case FontStyleNormal:
+		return "normal"
+	case FontStyleItalic:
+		return "italic"
+	case FontStyleOblique:
+		return "oblique"
+	}
+
+	panic(fmt.Sprintf("unknown FontStyleType: %#v", t))
+}
+
+func parseFontStyleType(s string) (FontStyleType, bool) {
+	switch s {
+	case "normal":
+		return FontStyleNormal, true
+	case "italic":
+		return FontStyleItalic, true
+	case "oblique":
+		return FontStyleOblique, true
+	}
+
+	return FontStyleUnset, false
+}
+
+type GridAutoFlowType int
+
+const (
+	GridAutoFlowUnset GridAutoFlowType = iota
+	GridAutoFlowRow
+	GridAutoFlowColumn
+	GridAutoFlowDense
+	GridAutoFlowRowDense
+	GridAutoFlowColumnDense
+)
+
+func (t GridAutoFlowType) String() string {
+	switch t {
+	case GridAutoFlowUnset:
+		return ""
+	case GridAutoFlowRow:
+		return "row"
+	case GridAutoFlowColumn:
+		return "column"
+	case GridAutoFlowDense:
+		return "dense"
+	case GridAutoFlowRowDense:
+		return "row dense"
+	case GridAutoFlowColumnDense:
+		return "column dense"
+	}
+
+	panic(fmt.Sprintf("unknown GridAutoFlowType: %#v", t))
+}
+
+func parseGridAutoFlowType(s string) (GridAutoFlowType, bool) {
+	switch s {
+	case "row":
+		return GridAutoFlowRow, true
+	case "column":
+		return GridAutoFlowColumn, true
+	case "dense":
+		return GridAutoFlowDense, true
+	case "row dense":
+		return GridAutoFlowRowDense, true
+	case "column dense":
+		return GridAutoFlowColumnDense, true
+	}
+
+	return GridAutoFlowUnset, false
+}
+
+type JustifyContentType int
+
+const (
+	JustifyContentUnset JustifyContentType = iota
+	JustifyContentCenter
+	JustifyContentSpaceBetween
+	JustifyContentFlexEnd
+	JustifyContentFlexStart
+	JustifyContentSpaceAround
+	JustifyContentSpaceEvenly
+	JustifyContentStart
+	JustifyContentEnd
+)
+
+func (t JustifyContentType) String() string {
+	switch t {
+	case JustifyContentUnset:
+		return ""
+	case JustifyContentCenter:
+		return "center"
+	case JustifyContentSpaceBetween:
+		return "space-between"
+	case JustifyContentFlexEnd:
+		return "flex-end"
+	case JustifyContentFlexStart:
+		return "flex-start"
+	case JustifyContentSpaceAround:
+		return "space-around"
+	case JustifyContentSpaceEvenly:
+		return "space-evenly"
+	case JustifyContentStart:
+		return "start"
+	case JustifyContentEnd:
+		return "end"
+	}
+
+	panic(fmt.Sprintf("unknown JustifyContentType: %#v", t))
+}
+
+func parseJustifyContentType(s string) (JustifyContentType, bool) {
+	switch s {
+	case "center":
+		return JustifyContentCenter, true
+	case "space-between":
+		return JustifyContentSpaceBetween, true
+	case "flex-end":
+		return JustifyContentFlexEnd, true
+	case "flex-start":
+		return JustifyContentFlexStart, true
+	case "space-around":
+		return JustifyContentSpaceAround, true
+	case "space-evenly":
+		return JustifyContentSpaceEvenly, true
+	case "start":
+		return JustifyContentStart, true
+	case "end":
+		return JustifyContentEnd, true
+	}
+
+	return JustifyContentUnset, false
+}
+
+type JustifyItemsType int
+
+const (
+	JustifyItemsUnset JustifyItemsType = iota
+	JustifyItemsStart
+	JustifyItemsEnd
+	JustifyItemsCenter
+	JustifyItemsStretch
+	JustifyItemsBaseline
+)
+
+func (t JustifyItemsType) String() string {
+	switch t {
+	case JustifyItemsUnset:
+		return ""
+	case JustifyItemsStart:
+		return "start"
+	case JustifyItemsEnd:
+		return "end"
+	case JustifyItemsCenter:
+		return "center"
+	case JustifyItemsStretch:
+		return "stretch"
+	case JustifyItemsBaseline:
+		return "baseline"
+	}
+
+	panic(fmt.Sprintf("unknown JustifyItemsType: %#v", t))
+}
+
+func parseJustifyItemsType(s string) (JustifyItemsType, bool) {
+	switch s {
+	case "start":
+		return JustifyItemsStart, true
+	case "end":
+		return JustifyItemsEnd, true
+	case "center":
+		return JustifyItemsCenter, true
+	case "stretch":
+		return JustifyItemsStretch, true
+	case "baseline":
+		return JustifyItemsBaseline, true
+	}
+
+	return JustifyItemsUnset, false
+}
+
+type JustifySelfType int
+
+const (
+	JustifySelfUnset JustifySelfType = iota
+	JustifySelfRight
+	JustifySelfLeft
+	JustifySelfFlexEnd
+	JustifySelfFlexStart
+	JustifySelfCenter
+	JustifySelfStart
+	JustifySelfEnd
+	JustifySelfStretch
+)
+
+func (t JustifySelfType) String() string {
+	switch t {
+	case JustifySelfUnset:
+		return ""
+	case JustifySelfRight:
+		return "right"
+	case JustifySelfLeft:
+		return "left"
+	case JustifySelfFlexEnd:
+		return "flex-end"
+	case JustifySelfFlexStart:
+		return "flex-start"
+	case JustifySelfCenter:
+		return "center"
+	case JustifySelfStart:
+		return "start"
+	case JustifySelfEnd:
+		return "end"
+	case JustifySelfStretch:
+		return "stretch"
+	}
+
+	panic(fmt.Sprintf("unknown JustifySelfType: %#v", t))
+}
+
+func parseJustifySelfType(s string) (JustifySelfType, bool) {
+	switch s {
+	case "right":
+		return JustifySelfRight, true
+	case "left":
+		return JustifySelfLeft, true
+	case "flex-end":
+		return JustifySelfFlexEnd, true
+	case "flex-start":
+		return JustifySelfFlexStart, true
+	case "center":
+		return JustifySelfCenter, true
+	case "start":
+		return JustifySelfStart, true
+	case "end":
+		return JustifySelfEnd, true
+	case "stretch":
+		return JustifySelfStretch, true
+	}
+
+	return JustifySelfUnset, false
+}
+
+type ObjectFitType int
+
+const (
+	ObjectFitUnset ObjectFitType = iota
+	ObjectFitFill
+	ObjectFitContain
+	ObjectFitCover
+	ObjectFitNone
+	ObjectFitScaleDown
+)
+
+func (t ObjectFitType) String() string {
+	switch t {
+	case ObjectFitUnset:
+		return ""
+	case ObjectFitFill:
+		return "fill"
+	case ObjectFitContain:
+		return "contain"
+	case ObjectFitCover:
+		return "cover"
+	case ObjectFitNone:
+		return "none"
+	case ObjectFitScaleDown:
+		return "scale-down"
+	}
+
+	panic(fmt.Sprintf("unknown ObjectFitType: %#v", t))
+}
+
+func parseObjectFitType(s string) (ObjectFitType, bool) {
+	switch s {
+	case "fill":
+		return ObjectFitFill, true
+	case "contain":
+		return ObjectFitContain, true
+	case "cover":
+		return ObjectFitCover, true
+	case "none":
+		return ObjectFitNone, true
+	case "scale-down":
+		return ObjectFitScaleDown, true
+	}
+
+	return ObjectFitUnset, false
+}
+
+type OutlineType int
+
+const (
+	OutlineUnset OutlineType = iota
+	OutlineNone
+	OutlineSolid
+	OutlineDashed
+	OutlineDotted
+)
+
+func (t OutlineType) String() string {
+	switch t {
+	case OutlineUnset:
+		return ""
+	case OutlineNone:
+		return "none"
+	case OutlineSolid:
+		return "solid"
+	case OutlineDashed:
+		return "dashed"
+	case OutlineDotted:
+		return "dotted"
+	}
+
+	panic(fmt.Sprintf("unknown OutlineType: %#v", t))
+}
+
+func parseOutlineType(s string) (OutlineType, bool) {
+	switch s {
+	case "none":
+		return OutlineNone, true
+	case "solid":
+		return OutlineSolid, true
+	case "dashed":
+		return OutlineDashed, true
+	case "dotted":
+		return OutlineDotted, true
+	}
+
+	return OutlineUnset, false
+}
+
+type OverflowType int
+
+const (
+	OverflowUnset OverflowType = iota
+	OverflowHidden
+	OverflowScroll
+	OverflowAuto
+	OverflowVisible
+)
+
+func (t OverflowType) String() string {
+	switch t {
+	case OverflowUnset:
+		return ""
+	case OverflowHidden:
+		return "hidden"
+	case OverflowScroll:
+		return "scroll"
+	case OverflowAuto:
+		return "auto"
+	case OverflowVisible:
+		return "visible"
+	}
+
+	panic(fmt.Sprintf("unknown OverflowType: %#v", t))
+}
+
+func parseOverflowType(s string) (OverflowType, bool) {
+	switch s {
+	case "hidden":
+		return OverflowHidden, true
+	case "scroll":
+		return OverflowScroll, true
+	case "auto":
+		return OverflowAuto, true
+	case "visible":
+		return OverflowVisible, true
+	}
+
+	return OverflowUnset, false
+}
+
+type PointerEventsType int
+
+const (
+	PointerEventsUnset PointerEventsType = iota
+	PointerEventsAuto
+	PointerEventsNone
+)
+
+func (t PointerEventsType) String() string {
+	switch t {
+	case PointerEventsUnset:
+		return ""
+	case PointerEventsAuto:
+		return "auto"
+	case PointerEventsNone:
+		return "none"
+	}
+
+	panic(fmt.Sprintf("unknown PointerEventsType: %#v", t))
+}
+
+func parsePointerEventsType(s string) (PointerEventsType, bool) {
+	switch s {
+	case "auto":
+		return PointerEventsAuto, true
+	case "none":
+		return PointerEventsNone, true
+	}
+
+	return PointerEventsUnset, false
+}
+
+type PositionType int
+
+const (
+	PositionUnset PositionType = iota
+	PositionRelative
+	PositionAbsolute
+	PositionFixed
+	PositionSticky
+	PositionStatic
+)
+
+func (t PositionType) String() string {
+	switch t {
+	case PositionUnset:
+		return ""
+	case PositionRelative:
+		return "relative"
+	case PositionAbsolute:
+		return "absolute"
+	case PositionFixed:
+		return "fixed"
+	case PositionSticky:
+		return "sticky"
+	case PositionStatic:
+		return "static"
+	}
+
+	panic(fmt.Sprintf("unknown PositionType: %#v", t))
+}
+
+func parsePositionType(s string) (PositionType, bool) {
+	switch s {
+	case "relative":
+		return PositionRelative, true
+	case "absolute":
+		return PositionAbsolute, true
+	case "fixed":
+		return PositionFixed, true
+	case "sticky":
+		return PositionSticky, true
+	case "static":
+		return PositionStatic, true
+	}
+
+	return PositionUnset, false
+}
+
+type TextAlignType int
+
+const (
+	TextAlignUnset TextAlignType = iota
+	TextAlignCenter
+	TextAlignRight
+	TextAlignLeft
+	TextAlignJustify
+)
+
+func (t TextAlignType) String() string {
+	switch t {
+	case TextAlignUnset:
+		return ""
+	case TextAlignCenter:
+		return "center"
+	case TextAlignRight:
+		return "right"
+	case TextAlignLeft:
+		return "left"
+	case TextAlignJustify:
+		return "justify"
+	}
+
+	panic(fmt.Sprintf("unknown TextAlignType: %#v", t))
+}
+
+func parseTextAlignType(s string) (TextAlignType, bool) {
+	switch s {
+	case "center":
+		return TextAlignCenter, true
+	case "right":
+		return TextAlignRight, true
+	case "left":
+		return TextAlignLeft, true
+	case "justify":
+		return TextAlignJustify, true
+	}
+
+	return TextAlignUnset, false
+}
+
+type TextDecorationType int
+
+const (
+	TextDecorationUnset TextDecorationType = iota
+	TextDecorationLineThrough
+	TextDecorationOverline
+	TextDecorationUnderline
+	TextDecorationNone
+)
+
+func (t TextDecorationType) String() string {
+	switch t {
+	case TextDecorationUnset:
+		return ""
+	case TextDecorationLineThrough:
+		return "line-through"
+	case TextDecorationOverline:
+		return "overline"
+	case TextDecorationUnderline:
+		return "underline"
+	case TextDecorationNone:
+		return "none"
+	}
+
+	panic(fmt.Sprintf("unknown TextDecorationType: %#v", t))
+}
+
+func parseTextDecorationType(s string) (TextDecorationType, bool) {
+	switch s {
+	case "line-through":
+		return TextDecorationLineThrough, true
+	case "overline":
+		return TextDecorationOverline, true
+	case "underline":
+		return TextDecorationUnderline, true
+	case "none":
+		return TextDecorationNone, true
+	}
+
+	return TextDecorationUnset, false
+}
+
+type TextOverflowType int
+
+const (
+	TextOverflowUnset TextOverflowType = iota
+	TextOverflowClip
+	TextOverflowEllipsis
+)
+
+func (t TextOverflowType) String() string {
+	switch t {
+	case TextOverflowUnset:
+		return ""
+	case TextOverflowClip:
+		return "clip"
+	case TextOverflowEllipsis:
+		return "ellipsis"
+	}
+
+	panic(fmt.Sprintf("unknown TextOverflowType: %#v", t))
+}
+
+func parseTextOverflowType(s string) (TextOverflowType, bool) {
+	switch s {
+	case "clip":
+		return TextOverflowClip, true
+	case "ellipsis":
+		return TextOverflowEllipsis, true
+	}
+
+	return TextOverflowUnset, false
+}
+
+type TextTransformType int
+
+const (
+	TextTransformUnset TextTransformType = iota
+	TextTransformNone
+	TextTransformUppercase
+	TextTransformLowercase
+	TextTransformCapitalize
+)
+
+func (t TextTransformType) String() string {
+	switch t {
+	case TextTransformUnset:
+		return ""
+	case TextTransformNone:
+		return "none"
+	case TextTransformUppercase:
+		return "uppercase"
+	case TextTransformLowercase:
+		return "lowercase"
+	case TextTransformCapitalize:
+		return "capitalize"
+	}
+
+	panic(fmt.Sprintf("unknown TextTransformType: %#v", t))
+}
+
+func parseTextTransformType(s string) (TextTransformType, bool) {
+	switch s {
+	case "none":
+		return TextTransformNone, true
+	case "uppercase":
+		return TextTransformUppercase, true
+	case "lowercase":
+		return TextTransformLowercase, true
+	case "capitalize":
+		return TextTransformCapitalize, true
+	}
+
+	return TextTransformUnset, false
+}
+
+type VerticalAlignType int
+
+const (
+	VerticalAlignUnset VerticalAlignType = iota
+	VerticalAlignBaseline
+	VerticalAlignTop
+	VerticalAlignMiddle
+	VerticalAlignBottom
+	VerticalAlignTextTop
+	VerticalAlignTextBottom
+)
+
+func (t VerticalAlignType) String() string {
+	switch t {
+	case VerticalAlignUnset:
+		return ""
+	case VerticalAlignBaseline:
+		return "baseline"
+	case VerticalAlignTop:
+		return "top"
+	case VerticalAlignMiddle:
+		return "middle"
+	case VerticalAlignBottom:
+		return "bottom"
+	case VerticalAlignTextTop:
+		return "text-top"
+	case VerticalAlignTextBottom:
+		return "text-bottom"
+	}
+
+	panic(fmt.Sprintf("unknown VerticalAlignType: %#v", t))
+}
+
+func parseVerticalAlignType(s string) (VerticalAlignType, bool) {
+	switch s {
+	case "baseline":
+		return VerticalAlignBaseline, true
+	case "top":
+		return VerticalAlignTop, true
+	case "middle":
+		return VerticalAlignMiddle, true
+	case "bottom":
+		return VerticalAlignBottom, true
+	case "text-top":
+		return VerticalAlignTextTop, true
+	case "text-bottom":
+		return VerticalAlignTextBottom, true
+	}
+
+	return VerticalAlignUnset, false
+}
+
+type VisibilityType int
+
+const (
+	VisibilityUnset VisibilityType = iota
+	VisibilityVisible
+	VisibilityHidden
+	VisibilityCollapse
+)
+
+func (t VisibilityType) String() string {
+	switch t {
+	case VisibilityUnset:
+		return ""
+	case VisibilityVisible:
+		return "visible"
+	case VisibilityHidden:
+		return "hidden"
+	case VisibilityCollapse:
+		return "collapse"
+	}
+
+	panic(fmt.Sprintf("unknown VisibilityType: %#v", t))
+}
+
+func parseVisibilityType(s string) (VisibilityType, bool) {
+	switch s {
+	case "visible":
+		return VisibilityVisible, true
+	case "hidden":
+		return VisibilityHidden, true
+	case "collapse":
+		return VisibilityCollapse, true
+	}
+
+	return VisibilityUnset, false
+}
+
+type WhiteSpaceType int
+
+const (
+	WhiteSpaceUnset WhiteSpaceType = iota
+	WhiteSpaceNormal
+	WhiteSpaceNoWrap
+	WhiteSpacePre
+	WhiteSpacePreWrap
+	WhiteSpacePreLine
+)
+
+func (t WhiteSpaceType) String() string {
+	switch t {
+	case WhiteSpaceUnset:
+		return ""
+	case WhiteSpaceNormal:
+		return "normal"
+	case WhiteSpaceNoWrap:
+		return "nowrap"
+	case WhiteSpacePre:
+		return "pre"
+	case WhiteSpacePreWrap:
+		return "pre-wrap"
+	case WhiteSpacePreLine:
+		return "pre-line"
+	}
+
+	panic(fmt.Sprintf("unknown WhiteSpaceType: %#v", t))
+}
+
+func parseWhiteSpaceType(s string) (WhiteSpaceType, bool) {
+	switch s {
+	case "normal":
+		return WhiteSpaceNormal, true
+	case "nowrap":
+		return WhiteSpaceNoWrap, true
+	case "pre":
+		return WhiteSpacePre, true
+	case "pre-wrap":
+		return WhiteSpacePreWrap, true
+	case "pre-line":
+		return WhiteSpacePreLine, true
+	}
+
+	return WhiteSpaceUnset, false
+}
+
+type WordBreakType int
+
+const (
+	WordBreakUnset WordBreakType = iota
+	WordBreakNormal
+	WordBreakBreakAll
+	WordBreakKeepAll
+	WordBreakBreakWord
+)
+
+func (t WordBreakType) String() string {
+	switch t {
+	case WordBreakUnset:
+		return ""
+	case WordBreakNormal:
+		return "normal"
+	case WordBreakBreakAll:
+		return "break-all"
+	case WordBreakKeepAll:
+		return "keep-all"
+	case WordBreakBreakWord:
+		return "break-word"
+	}
+
+	panic(fmt.Sprintf("unknown WordBreakType: %#v", t))
+}
+
+func parseWordBreakType(s string) (WordBreakType, bool) {
+	switch s {
+	case "normal":
+		return WordBreakNormal, true
+	case "break-all":
+		return WordBreakBreakAll, true
+	case "keep-all":
+		return WordBreakKeepAll, true
+	case "break-word":
+		return WordBreakBreakWord, true
+	}
+
+	return WordBreakUnset, false
+}
+
+// }}}
+
+// Style {{{
+
+// Style is the inline style of a Node. Each field is a CSS property;
+// the zero value of a field leaves the property unset.
+//
+// As Vars and Breakpoints are a map and a slice, Style is not comparable
+// with ==; use Equal.
+type Style struct {
+	AlignItems          AlignItemsType
+	AlignSelf           AlignSelfType
+	Animation           Animation
+	Background          string
+	BackgroundColor     string
+	BackgroundImage     string
+	BackgroundPosition  string
+	BackgroundRepeat    string
+	BackgroundSize      string
+	Border              Border
+	BorderBottom        Border
+	BorderLeft          Border
+	BorderRight         Border
+	BorderTop           Border
+	BorderColor         string
+	BorderRadius        Size
+	Bottom              Size
+	BoxShadow           BoxShadow
+	BoxSizing           BoxSizingType
+	Color               string
+	Cursor              CursorType
+	Display             DisplayType
+	FlexBasis           string
+	FlexDirection       FlexDirectionType
+	FlexGrow            string
+	FlexShrink          string
+	FlexWrap            FlexWrapType
+	FontFamily          string
+	FontSize            Size
+	FontStyle           FontStyleType
+	FontWeight          string
+	Gap                 Size
+	ColumnGap           Size
+	RowGap              Size
+	GridArea            string
+	GridAutoColumns     TrackList
+	GridAutoFlow        GridAutoFlowType
+	GridAutoRows        TrackList
+	GridColumn          GridLine
+	GridRow             GridLine
+	GridTemplateAreas   GridAreas
+	GridTemplateColumns TrackList
+	GridTemplateRows    TrackList
+	Height              Size
+	JustifyContent      JustifyContentType
+	JustifyItems        JustifyItemsType
+	JustifySelf         JustifySelfType
+	Left                Size
+	LetterSpacing       Size
+	LineHeight          string
+	Margin              Size
+	MarginBottom        Size
+	MarginLeft          Size
+	MarginRight         Size
+	MarginTop           Size
+	MaxHeight           Size
+	MaxWidth            Size
+	MinHeight           Size
+	MinWidth            Size
+	ObjectFit           ObjectFitType
+	Opacity             string
+	Order               string
+	Outline             Outline
+	Overflow            OverflowType
+	OverflowX           OverflowType
+	OverflowY           OverflowType
+	Padding             Size
+	PaddingBottom       Size
+	PaddingLeft         Size
+	PaddingRight        Size
+	PaddingTop          Size
+	PointerEvents       PointerEventsType
+	Position            PositionType
+	Right               Size
+	TextAlign           TextAlignType
+	TextDecoration      TextDecorationType
+	TextOverflow        TextOverflowType
+	TextTransform       TextTransformType
+	Top                 Size
+	Transform           Transform
+	Transition          TransitionList
+	UserSelect          string
+	VerticalAlign       VerticalAlignType
+	Visibility          VisibilityType
+	WhiteSpace          WhiteSpaceType
+	Width               Size
+	WordBreak           WordBreakType
+	ZIndex              string
+
+	// Vars are the custom properties, keyed by name (e.g., "--accent").
+	// Set them with Node.SetVar, which copies the map before writing.
+	Vars map[string]string
+
+	// The variants are styles for a state of the element, which inline
+	// styles can't express. The Mounter writes them as rules for a
+	// generated class, see stylesheet.go.
+	Hover        *Style
+	Focus        *Style
+	FocusVisible *Style
+	FocusWithin  *Style
+	Active       *Style
+	Disabled     *Style
+	Placeholder  *Style
+
+	// Breakpoints are styles for wide viewports, which the Mounter also
+	// writes as rules; set them with Node.Breakpoint.
+	Breakpoints []Breakpoint
+}
+
+// Val encodes the set properties as the value of a style attribute.
+func (s *Style) Val() string {
+	if s == nil {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	w := &buf
+
+	s.encodeVars(w)
+
+	if s.AlignItems != AlignItemsUnset {
+		fmt.Fprintf(w, "align-items:%s;", s.AlignItems)
+	}
+
+	if s.AlignSelf != AlignSelfUnset {
+		fmt.Fprintf(w, "align-self:%s;", s.AlignSelf)
+	}
+
+	if !s.Animation.IsZero() {
+		fmt.Fprintf(w, "animation:%s;", &s.Animation)
+	}
+
+	if s.Background != "" {
+		fmt.Fprintf(w, "background:%s;", s.Background)
+	}
+
+	if s.BackgroundColor != "" {
+		fmt.Fprintf(w, "background-color:%s;", s.BackgroundColor)
+	}
+
+	if s.BackgroundImage != "" {
+		fmt.Fprintf(w, "background-image:%s;", s.BackgroundImage)
+	}
+
+	if s.BackgroundPosition != "" {
+		fmt.Fprintf(w, "background-position:%s;", s.BackgroundPosition)
+	}
+
+	if s.BackgroundRepeat != "" {
+		fmt.Fprintf(w, "background-repeat:%s;", s.BackgroundRepeat)
+	}
+
+	if s.BackgroundSize != "" {
+		fmt.Fprintf(w, "background-size:%s;", s.BackgroundSize)
+	}
+
+	if s.Border.Type != BorderUnset {
+		fmt.Fprintf(w, "border:%s;", &s.Border)
+	}
+
+	if s.BorderBottom.Type != BorderUnset {
+		fmt.Fprintf(w, "border-bottom:%s;", &s.BorderBottom)
+	}
+
+	if s.BorderLeft.Type != BorderUnset {
+		fmt.Fprintf(w, "border-left:%s;", &s.BorderLeft)
+	}
+
+	if s.BorderRight.Type != BorderUnset {
+		fmt.Fprintf(w, "border-right:%s;", &s.BorderRight)
+	}
+
+	if s.BorderTop.Type != BorderUnset {
+		fmt.Fprintf(w, "border-top:%s;", &s.BorderTop)
+	}
+
+	if s.BorderColor != "" {
+		fmt.Fprintf(w, "border-color:%s;", s.BorderColor)
+	}
+
+	if !s.BorderRadius.IsZero() {
+		fmt.Fprintf(w, "border-radius:%s;", &s.BorderRadius)
+	}
+
+	if !s.Bottom.IsZero() {
+		fmt.Fprintf(w, "bottom:%s;", &s.Bottom)
+	}
+
+	if !s.BoxShadow.IsZero() {
+		fmt.Fprintf(w, "box-shadow:%s;", &s.BoxShadow)
+	}
+
+	if s.BoxSizing != BoxSizingUnset {
+		fmt.Fprintf(w, "box-sizing:%s;", s.BoxSizing)
+	}
+
+	if s.Color != "" {
+		fmt.Fprintf(w, "color:%s;", s.Color)
+	}
+
+	if s.Cursor != CursorUnset {
+		fmt.Fprintf(w, "cursor:%s;", s.Cursor)
+	}
+
+	if s.Display != DisplayUnset {
+		fmt.Fprintf(w, "display:%s;", s.Display)
+	}
+
+	if s.FlexBasis != "" {
+		fmt.Fprintf(w, "flex-basis:%s;", s.FlexBasis)
+	}
+
+	if s.FlexDirection != FlexDirectionUnset {
+		fmt.Fprintf(w, "flex-direction:%s;", s.FlexDirection)
+	}
+
+	if s.FlexGrow != "" {
+		fmt.Fprintf(w, "flex-grow:%s;", s.FlexGrow)
+	}
+
+	if s.FlexShrink != "" {
+		fmt.Fprintf(w, "flex-shrink:%s;", s.FlexShrink)
+	}
+
+	if s.FlexWrap != FlexWrapUnset {
+		fmt.Fprintf(w, "flex-wrap:%s;", s.FlexWrap)
+	}
+
+	if s.FontFamily != "" {
+		fmt.Fprintf(w, "font-family:%s;", s.FontFamily)
+	}
+
+	if !s.FontSize.IsZero() {
+		fmt.Fprintf(w, "font-size:%s;", &s.FontSize)
+	}
+
+	if s.FontStyle != FontStyleUnset {
+		fmt.Fprintf(w, "font-style:%s;", s.FontStyle)
+	}
+
+	if s.FontWeight != "" {
+		fmt.Fprintf(w, "font-weight:%s;", s.FontWeight)
+	}
+
+	if !s.Gap.IsZero() {
+		fmt.Fprintf(w, "gap:%s;", &s.Gap)
+	}
+
+	if !s.ColumnGap.IsZero() {
+		fmt.Fprintf(w, "column-gap:%s;", &s.ColumnGap)
+	}
+
+	if !s.RowGap.IsZero() {
+		fmt.Fprintf(w, "row-gap:%s;", &s.RowGap)
+	}
+
+	if s.GridArea != "" {
+		fmt.Fprintf(w, "grid-area:%s;", s.GridArea)
+	}
+
+	if s.GridAutoColumns != "" {
+		fmt.Fprintf(w, "grid-auto-columns:%s;", s.GridAutoColumns)
+	}
+
+	if s.GridAutoFlow != GridAutoFlowUnset {
+		fmt.Fprintf(w, "grid-auto-flow:%s;", s.GridAutoFlow)
+	}
+
+	if s.GridAutoRows != "" {
+		fmt.Fprintf(w, "grid-auto-rows:%s;", s.GridAutoRows)
+	}
+
+	if s.GridColumn != "" {
+		fmt.Fprintf(w, "grid-column:%s;", s.GridColumn)
+	}
+
+	if s.GridRow != "" {
+		fmt.Fprintf(w, "grid-row:%s;", s.GridRow)
+	}
+
+	if s.GridTemplateAreas != "" {
+		fmt.Fprintf(w, "grid-template-areas:%s;", s.GridTemplateAreas)
+	}
+
+	if s.GridTemplateColumns != "" {
+		fmt.Fprintf(w, "grid-template-columns:%s;", s.GridTemplateColumns)
+	}
+
+	if s.GridTemplateRows != "" {
+		fmt.Fprintf(w, "grid-template-rows:%s;", s.GridTemplateRows)
+	}
+
+	if !s.Height.IsZero() {
+		fmt.Fprintf(w, "height:%s;", &s.Height)
+	}
+
+	if s.JustifyContent != JustifyContentUnset {
+		fmt.Fprintf(w, "justify-content:%s;", s.JustifyContent)
+	}
+
+	if s.JustifyItems != JustifyItemsUnset {
+		fmt.Fprintf(w, "justify-items:%s;", s.JustifyItems)
+	}
+
+	if s.JustifySelf != JustifySelfUnset {
+		fmt.Fprintf(w, "justify-self:%s;", s.JustifySelf)
+	}
+
+	if !s.Left.IsZero() {
+		fmt.Fprintf(w, "left:%s;", &s.Left)
+	}
+
+	if !s.LetterSpacing.IsZero() {
+		fmt.Fprintf(w, "letter-spacing:%s;", &s.LetterSpacing)
+	}
+
+	if s.LineHeight != "" {
+		fmt.Fprintf(w, "line-height:%s;", s.LineHeight)
+	}
+
+	if !s.Margin.IsZero() {
+		fmt.Fprintf(w, "margin:%s;", &s.Margin)
+	}
+
+	if !s.MarginBottom.IsZero() {
+		fmt.Fprintf(w, "margin-bottom:%s;", &s.MarginBottom)
+	}
+
+	if !s.MarginLeft.IsZero() {
+		fmt.Fprintf(w, "margin-left:%s;", &s.MarginLeft)
+	}
+
+	if !s.MarginRight.IsZero() {
+		fmt.Fprintf(w, "margin-right:%s;", &s.MarginRight)
+	}
+
+	if !s.MarginTop.IsZero() {
+		fmt.Fprintf(w, "margin-top:%s;", &s.MarginTop)
+	}
+
+	if !s.MaxHeight.IsZero() {
+		fmt.Fprintf(w, "max-height:%s;", &s.MaxHeight)
+	}
+
+	if !s.MaxWidth.IsZero() {
+		fmt.Fprintf(w, "max-width:%s;", &s.MaxWidth)
+	}
+
+	if !s.MinHeight.IsZero() {
+		fmt.Fprintf(w, "min-height:%s;", &s.MinHeight)
+	}
+
+	if !s.MinWidth.IsZero() {
+		fmt.Fprintf(w, "min-width:%s;", &s.MinWidth)
+	}
+
+	if s.ObjectFit != ObjectFitUnset {
+		fmt.Fprintf(w, "object-fit:%s;", s.ObjectFit)
+	}
+
+	if s.Opacity != "" {
+		fmt.Fprintf(w, "opacity:%s;", s.Opacity)
+	}
+
+	if s.Order != "" {
+		fmt.Fprintf(w, "order:%s;", s.Order)
+	}
+
+	if !s.Outline.IsZero() {
+		fmt.Fprintf(w, "outline:%s;", &s.Outline)
+	}
+
+	if s.Overflow != OverflowUnset {
+		fmt.Fprintf(w, "overflow:%s;", s.Overflow)
+	}
+
+	if s.OverflowX != OverflowUnset {
+		fmt.Fprintf(w, "overflow-x:%s;", s.OverflowX)
+	}
+
+	if s.OverflowY != OverflowUnset {
+		fmt.Fprintf(w, "overflow-y:%s;", s.OverflowY)
+	}
+
+	if !s.Padding.IsZero() {
+		fmt.Fprintf(w, "padding:%s;", &s.Padding)
+	}
+
+	if !s.PaddingBottom.IsZero() {
+		fmt.Fprintf(w, "padding-bottom:%s;", &s.PaddingBottom)
+	}
+
+	if !s.PaddingLeft.IsZero() {
+		fmt.Fprintf(w, "padding-left:%s;", &s.PaddingLeft)
+	}
+
+	if !s.PaddingRight.IsZero() {
+		fmt.Fprintf(w, "padding-right:%s;", &s.PaddingRight)
+	}
+
+	if !s.PaddingTop.IsZero() {
+		fmt.Fprintf(w, "padding-top:%s;", &s.PaddingTop)
+	}
+
+	if s.PointerEvents != PointerEventsUnset {
+		fmt.Fprintf(w, "pointer-events:%s;", s.PointerEvents)
+	}
+
+	if s.Position != PositionUnset {
+		fmt.Fprintf(w, "position:%s;", s.Position)
+	}
+
+	if !s.Right.IsZero() {
+		fmt.Fprintf(w, "right:%s;", &s.Right)
+	}
+
+	if s.TextAlign != TextAlignUnset {
+		fmt.Fprintf(w, "text-align:%s;", s.TextAlign)
+	}
+
+	if s.TextDecoration != TextDecorationUnset {
+		fmt.Fprintf(w, "text-decoration:%s;", s.TextDecoration)
+	}
+
+	if s.TextOverflow != TextOverflowUnset {
+		fmt.Fprintf(w, "text-overflow:%s;", s.TextOverflow)
+	}
+
+	if s.TextTransform != TextTransformUnset {
+		fmt.Fprintf(w, "text-transform:%s;", s.TextTransform)
+	}
+
+	if !s.Top.IsZero() {
+		fmt.Fprintf(w, "top:%s;", &s.Top)
+	}
+
+	if s.Transform != "" {
+		fmt.Fprintf(w, "transform:%s;", s.Transform)
+	}
+
+	if s.Transition != "" {
+		fmt.Fprintf(w, "transition:%s;", s.Transition)
+	}
+
+	if s.UserSelect != "" {
+		fmt.Fprintf(w, "user-select:%s;", s.UserSelect)
+		fmt.Fprintf(w, "-webkit-user-select:%s;", s.UserSelect)
+		fmt.Fprintf(w, "-moz-user-select:%s;", s.UserSelect)
+		fmt.Fprintf(w, "-ms-user-select:%s;", s.UserSelect)
+	}
+
+	if s.VerticalAlign != VerticalAlignUnset {
+		fmt.Fprintf(w, "vertical-align:%s;", s.VerticalAlign)
+	}
+
+	if s.Visibility != VisibilityUnset {
+		fmt.Fprintf(w, "visibility:%s;", s.Visibility)
+	}
+
+	if s.WhiteSpace != WhiteSpaceUnset {
+		fmt.Fprintf(w, "white-space:%s;", s.WhiteSpace)
+	}
+
+	if !s.Width.IsZero() {
+		fmt.Fprintf(w, "width:%s;", &s.Width)
+	}
+
+	if s.WordBreak != WordBreakUnset {
+		fmt.Fprintf(w, "word-break:%s;", s.WordBreak)
+	}
+
+	if s.ZIndex != "" {
+		fmt.Fprintf(w, "z-index:%s;", s.ZIndex)
+	}
+
+	return buf.String()
+}
+
+// Equal reports whether s and t set the same properties to the same values.
+func (s *Style) Equal(t *Style) bool {
+	if s == nil || t == nil {
+		return s == t
+	}
+
+	return s.AlignItems == t.AlignItems &&
+		s.AlignSelf == t.AlignSelf &&
+		s.Animation == t.Animation &&
+		s.Background == t.Background &&
+		s.BackgroundColor == t.BackgroundColor &&
+		s.BackgroundImage == t.BackgroundImage &&
+		s.BackgroundPosition == t.BackgroundPosition &&
+		s.BackgroundRepeat == t.BackgroundRepeat &&
+		s.BackgroundSize == t.BackgroundSize &&
+		s.Border == t.Border &&
+		s.BorderBottom == t.BorderBottom &&
+		s.BorderLeft == t.BorderLeft &&
+		s.BorderRight == t.BorderRight &&
+		s.BorderTop == t.BorderTop &&
+		s.BorderColor == t.BorderColor &&
+		s.BorderRadius == t.BorderRadius &&
+		s.Bottom == t.Bottom &&
+		s.BoxShadow == t.BoxShadow &&
+		s.BoxSizing == t.BoxSizing &&
+		s.Color == t.Color &&
+		s.Cursor == t.Cursor &&
+		s.Display == t.Display &&
+		s.FlexBasis == t.FlexBasis &&
+		s.FlexDirection == t.FlexDirection &&
+		s.FlexGrow == t.FlexGrow &&
+		s.FlexShrink == t.FlexShrink &&
+		s.FlexWrap == t.FlexWrap &&
+		s.FontFamily == t.FontFamily &&
+		s.FontSize == t.FontSize &&
+		s.FontStyle == t.FontStyle &&
+		s.FontWeight == t.FontWeight &&
+		s.Gap == t.Gap &&
+		s.ColumnGap == t.ColumnGap &&
+		s.RowGap == t.RowGap &&
+		s.GridArea == t.GridArea &&
+		s.GridAutoColumns == t.GridAutoColumns &&
+		s.GridAutoFlow == t.GridAutoFlow &&
+		s.GridAutoRows == t.GridAutoRows &&
+		s.GridColumn == t.GridColumn &&
+		s.GridRow == t.GridRow &&
+		s.GridTemplateAreas == t.GridTemplateAreas &&
+		s.GridTemplateColumns == t.GridTemplateColumns &&
+		s.GridTemplateRows == t.GridTemplateRows &&
+		s.Height == t.Height &&
+		s.JustifyContent == t.JustifyContent &&
+		s.JustifyItems == t.JustifyItems &&
+		s.JustifySelf == t.JustifySelf &&
+		s.Left == t.Left &&
+		s.LetterSpacing == t.LetterSpacing &&
+		s.LineHeight == t.LineHeight &&
+		s.Margin == t.Margin &&
+		s.MarginBottom == t.MarginBottom &&
+		s.MarginLeft == t.MarginLeft &&
+		s.MarginRight == t.MarginRight &&
+		s.MarginTop == t.MarginTop &&
+		s.MaxHeight == t.MaxHeight &&
+		s.MaxWidth == t.MaxWidth &&
+		s.MinHeight == t.MinHeight &&
+		s.MinWidth == t.MinWidth &&
+		s.ObjectFit == t.ObjectFit &&
+		s.Opacity == t.Opacity &&
+		s.Order == t.Order &&
+		s.Outline == t.Outline &&
+		s.Overflow == t.Overflow &&
+		s.OverflowX == t.OverflowX &&
+		s.OverflowY == t.OverflowY &&
+		s.Padding == t.Padding &&
+		s.PaddingBottom == t.PaddingBottom &&
+		s.PaddingLeft == t.PaddingLeft &&
+		s.PaddingRight == t.PaddingRight &&
+		s.PaddingTop == t.PaddingTop &&
+		s.PointerEvents == t.PointerEvents &&
+		s.Position == t.Position &&
+		s.Right == t.Right &&
+		s.TextAlign == t.TextAlign &&
+		s.TextDecoration == t.TextDecoration &&
+		s.TextOverflow == t.TextOverflow &&
+		s.TextTransform == t.TextTransform &&
+		s.Top == t.Top &&
+		s.Transform == t.Transform &&
+		s.Transition == t.Transition &&
+		s.UserSelect == t.UserSelect &&
+		s.VerticalAlign == t.VerticalAlign &&
+		s.Visibility == t.Visibility &&
+		s.WhiteSpace == t.WhiteSpace &&
+		s.Width == t.Width &&
+		s.WordBreak == t.WordBreak &&
+		s.ZIndex == t.ZIndex &&
+		varsEqual(s.Vars, t.Vars) &&
+		s.Hover.Equal(t.Hover) &&
+		s.Focus.Equal(t.Focus) &&
+		s.FocusVisible.Equal(t.FocusVisible) &&
+		s.FocusWithin.Equal(t.FocusWithin) &&
+		s.Active.Equal(t.Active) &&
+		s.Disabled.Equal(t.Disabled) &&
+		s.Placeholder.Equal(t.Placeholder) &&
+		breakpointsEqual(s.Breakpoints, t.Breakpoints)
+}
+
+// Merge overlays t on s: each property t sets, and each of its custom
+// properties, overrides s's, while those t leaves unset keep s's value.
+// A variant set in both is merged in the same way, and t's breakpoints
+// follow s's, so that they win at the same width. A shorthand t sets
+// (e.g., Padding) doesn't unset the longhands s sets (e.g., PaddingLeft).
+// Merge doesn't write to the maps, slices or variants s may share.
+func (s *Style) Merge(t Style) {
+	if t.AlignItems != AlignItemsUnset {
+		s.AlignItems = t.AlignItems
+	}
+	if t.AlignSelf != AlignSelfUnset {
+		s.AlignSelf = t.AlignSelf
+	}
+	if !t.Animation.IsZero() {
+		s.Animation = t.Animation
+	}
+	if t.Background != "" {
+		s.Background = t.Background
+	}
+	if t.BackgroundColor != "" {
+		s.BackgroundColor = t.BackgroundColor
+	}
+	if t.BackgroundImage != "" {
+		s.BackgroundImage = t.BackgroundImage
+	}
+	if t.BackgroundPosition != "" {
+		s.BackgroundPosition = t.BackgroundPosition
+	}
+	if t.BackgroundRepeat != "" {
+		s.BackgroundRepeat = t.BackgroundRepeat
+	}
+	if t.BackgroundSize != "" {
+		s.BackgroundSize = t.BackgroundSize
+	}
+	if t.Border.Type != BorderUnset {
+		s.Border = t.Border
+	}
+	if t.BorderBottom.Type != BorderUnset {
+		s.BorderBottom = t.BorderBottom
+	}
+	if t.BorderLeft.Type != BorderUnset {
+		s.BorderLeft = t.BorderLeft
+	}
+	if t.BorderRight.Type != BorderUnset {
+		s.BorderRight = t.BorderRight
+	}
+	if t.BorderTop.Type != BorderUnset {
+		s.BorderTop = t.BorderTop
+	}
+	if t.BorderColor != "" {
+		s.BorderColor = t.BorderColor
+	}
+	if !t.BorderRadius.IsZero() {
+		s.BorderRadius = t.BorderRadius
+	}
+	if !t.Bottom.IsZero() {
+		s.Bottom = t.Bottom
+	}
+	if !t.BoxShadow.IsZero() {
+		s.BoxShadow = t.BoxShadow
+	}
+	if t.BoxSizing != BoxSizingUnset {
+		s.BoxSizing = t.BoxSizing
+	}
+	if t.Color != "" {
+		s.Color = t.Color
+	}
+	if t.Cursor != CursorUnset {
+		s.Cursor = t.Cursor
+	}
+	if t.Display != DisplayUnset {
+		s.Display = t.Display
+	}
+	if t.FlexBasis != "" {
+		s.FlexBasis = t.FlexBasis
+	}
+	if t.FlexDirection != FlexDirectionUnset {
+		s.FlexDirection = t.FlexDirection
+	}
+	if t.FlexGrow != "" {
+		s.FlexGrow = t.FlexGrow
+	}
+	if t.FlexShrink != "" {
+		s.FlexShrink = t.FlexShrink
+	}
+	if t.FlexWrap != FlexWrapUnset {
+		s.FlexWrap = t.FlexWrap
+	}
+	if t.FontFamily != "" {
+		s.FontFamily = t.FontFamily
+	}
+	if !t.FontSize.IsZero() {
+		s.FontSize = t.FontSize
+	}
+	if t.FontStyle != FontStyleUnset {
+		s.FontStyle = t.FontStyle
+	}
+	if t.FontWeight != "" {
+		s.FontWeight = t.FontWeight
+	}
+	if !t.Gap.IsZero() {
+		s.Gap = t.Gap
+	}
+	if !t.ColumnGap.IsZero() {
+		s.ColumnGap = t.ColumnGap
+	}
+	if !t.RowGap.IsZero() {
+		s.RowGap = t.RowGap
+	}
+	if t.GridArea != "" {
+		s.GridArea = t.GridArea
+	}
+	if t.GridAutoColumns != "" {
+		s.GridAutoColumns = t.GridAutoColumns
+	}
+	if t.GridAutoFlow != GridAutoFlowUnset {
+		s.GridAutoFlow = t.GridAutoFlow
+	}
+	if t.GridAutoRows != "" {
+		s.GridAutoRows = t.GridAutoRows
+	}
+	if t.GridColumn != "" {
+		s.GridColumn = t.GridColumn
+	}
+	if t.GridRow != "" {
+		s.GridRow = t.GridRow
+	}
+	if t.GridTemplateAreas != "" {
+		s.GridTemplateAreas = t.GridTemplateAreas
+	}
+	if t.GridTemplateColumns != "" {
+		s.GridTemplateColumns = t.GridTemplateColumns
+	}
+	if t.GridTemplateRows != "" {
+		s.GridTemplateRows = t.GridTemplateRows
+	}
+	if !t.Height.IsZero() {
+		s.Height = t.Height
+	}
+	if t.JustifyContent != JustifyContentUnset {
+		s.JustifyContent = t.JustifyContent
+	}
+	if t.JustifyItems != JustifyItemsUnset {
+		s.JustifyItems = t.JustifyItems
+	}
+	if t.JustifySelf != JustifySelfUnset {
+		s.JustifySelf = t.JustifySelf
+	}
+	if !t.Left.IsZero() {
+		s.Left = t.Left
+	}
+	if !t.LetterSpacing.IsZero() {
+		s.LetterSpacing = t.LetterSpacing
+	}
+	if t.LineHeight != "" {
+		s.LineHeight = t.LineHeight
+	}
+	if !t.Margin.IsZero() {
+		s.Margin = t.Margin
+	}
+	if !t.MarginBottom.IsZero() {
+		s.MarginBottom = t.MarginBottom
+	}
+	if !t.MarginLeft.IsZero() {
+		s.MarginLeft = t.MarginLeft
+	}
+	if !t.MarginRight.IsZero() {
+		s.MarginRight = t.MarginRight
+	}
+	if !t.MarginTop.IsZero() {
+		s.MarginTop = t.MarginTop
+	}
+	if !t.MaxHeight.IsZero() {
+		s.MaxHeight = t.MaxHeight
+	}
+	if !t.MaxWidth.IsZero() {
+		s.MaxWidth = t.MaxWidth
+	}
+	if !t.MinHeight.IsZero() {
+		s.MinHeight = t.MinHeight
+	}
+	if !t.MinWidth.IsZero() {
+		s.MinWidth = t.MinWidth
+	}
+	if t.ObjectFit != ObjectFitUnset {
+		s.ObjectFit = t.ObjectFit
+	}
+	if t.Opacity != "" {
+		s.Opacity = t.Opacity
+	}
+	if t.Order != "" {
+		s.Order = t.Order
+	}
+	if !t.Outline.IsZero() {
+		s.Outline = t.Outline
+	}
+	if t.Overflow != OverflowUnset {
+		s.Overflow = t.Overflow
+	}
+	if t.OverflowX != OverflowUnset {
+		s.OverflowX = t.OverflowX
+	}
+	if t.OverflowY != OverflowUnset {
+		s.OverflowY = t.OverflowY
+	}
+	if !t.Padding.IsZero() {
+		s.Padding = t.Padding
+	}
+	if !t.PaddingBottom.IsZero() {
+		s.PaddingBottom = t.PaddingBottom
+	}
+	if !t.PaddingLeft.IsZero() {
+		s.PaddingLeft = t.PaddingLeft
+	}
+	if !t.PaddingRight.IsZero() {
+		s.PaddingRight = t.PaddingRight
+	}
+	if !t.PaddingTop.IsZero() {
+		s.PaddingTop = t.PaddingTop
+	}
+	if t.PointerEvents != PointerEventsUnset {
+		s.PointerEvents = t.PointerEvents
+	}
+	if t.Position != PositionUnset {
+		s.Position = t.Position
+	}
+	if !t.Right.IsZero() {
+		s.Right = t.Right
+	}
+	if t.TextAlign != TextAlignUnset {
+		s.TextAlign = t.TextAlign
+	}
+	if t.TextDecoration != TextDecorationUnset {
+		s.TextDecoration = t.TextDecoration
+	}
+	if t.TextOverflow != TextOverflowUnset {
+		s.TextOverflow = t.TextOverflow
+	}
+	if t.TextTransform != TextTransformUnset {
+		s.TextTransform = t.TextTransform
+	}
+	if !t.Top.IsZero() {
+		s.Top = t.Top
+	}
+	if t.Transform != "" {
+		s.Transform = t.Transform
+	}
+	if t.Transition != "" {
+		s.Transition = t.Transition
+	}
+	if t.UserSelect != "" {
+		s.UserSelect = t.UserSelect
+	}
+	if t.VerticalAlign != VerticalAlignUnset {
+		s.VerticalAlign = t.VerticalAlign
+	}
+	if t.Visibility != VisibilityUnset {
+		s.Visibility = t.Visibility
+	}
+	if t.WhiteSpace != WhiteSpaceUnset {
+		s.WhiteSpace = t.WhiteSpace
+	}
+	if !t.Width.IsZero() {
+		s.Width = t.Width
+	}
+	if t.WordBreak != WordBreakUnset {
+		s.WordBreak = t.WordBreak
+	}
+	if t.ZIndex != "" {
+		s.ZIndex = t.ZIndex
+	}
+
+	s.Vars = mergeVars(s.Vars, t.Vars)
+	s.Hover = mergeVariant(s.Hover, t.Hover)
+	s.Focus = mergeVariant(s.Focus, t.Focus)
+	s.FocusVisible = mergeVariant(s.FocusVisible, t.FocusVisible)
+	s.FocusWithin = mergeVariant(s.FocusWithin, t.FocusWithin)
+	s.Active = mergeVariant(s.Active, t.Active)
+	s.Disabled = mergeVariant(s.Disabled, t.Disabled)
+	s.Placeholder = mergeVariant(s.Placeholder, t.Placeholder)
+	s.Breakpoints = mergeBreakpoints(s.Breakpoints, t.Breakpoints)
+}
+
+// variants returns the variants which are set.
+func (s *Style) variants() (vs []variant) {
+	if s.Hover != nil {
+		vs = append(vs, variant{":hover", s.Hover})
+	}
+	if s.Focus != nil {
+		vs = append(vs, variant{":focus", s.Focus})
+	}
+	if s.FocusVisible != nil {
+		vs = append(vs, variant{":focus-visible", s.FocusVisible})
+	}
+	if s.FocusWithin != nil {
+		vs = append(vs, variant{":focus-within", s.FocusWithin})
+	}
+	if s.Active != nil {
+		vs = append(vs, variant{":active", s.Active})
+	}
+	if s.Disabled != nil {
+		vs = append(vs, variant{":disabled", s.Disabled})
+	}
+	if s.Placeholder != nil {
+		vs = append(vs, variant{"::placeholder", s.Placeholder})
+	}
+	return vs
+}
+
+// setVariant sets the variant for the selector, reporting whether the
+// selector is one.
+func (s *Style) setVariant(selector string, v *Style) bool {
+	switch selector {
+	case ":hover":
+		s.Hover = v
+	case ":focus":
+		s.Focus = v
+	case ":focus-visible":
+		s.FocusVisible = v
+	case ":focus-within":
+		s.FocusWithin = v
+	case ":active":
+		s.Active = v
+	case ":disabled":
+		s.Disabled = v
+	case "::placeholder":
+		s.Placeholder = v
+	default:
+		return false
+	}
+	return true
+}
+
+// parseProperty sets the property, reporting whether the name and value
+// were recognized. The name is lower case, and the value trimmed.
+func (s *Style) parseProperty(name, value string) bool {
+	switch name {
+	case "align-items":
+		v, ok := parseAlignItemsType(strings.ToLower(value))
+		if ok {
+			s.AlignItems = v
+		}
+		return ok
+	case "align-self":
+		v, ok := parseAlignSelfType(strings.ToLower(value))
+		if ok {
+			s.AlignSelf = v
+		}
+		return ok
+	case "animation":
+		v, ok := parseAnimation(value)
+		if ok {
+			s.Animation = v
+		}
+		return ok
+	case "background":
+		s.Background = value
+		return true
+	case "background-color":
+		s.BackgroundColor = value
+		return true
+	case "background-image":
+		s.BackgroundImage = value
+		return true
+	case "background-position":
+		s.BackgroundPosition = value
+		return true
+	case "background-repeat":
+		s.BackgroundRepeat = value
+		return true
+	case "background-size":
+		s.BackgroundSize = value
+		return true
+	case "border":
+		v, ok := parseBorder(value)
+		if ok {
+			s.Border = v
+		}
+		return ok
+	case "border-bottom":
+		v, ok := parseBorder(value)
+		if ok {
+			s.BorderBottom = v
+		}
+		return ok
+	case "border-left":
+		v, ok := parseBorder(value)
+		if ok {
+			s.BorderLeft = v
+		}
+		return ok
+	case "border-right":
+		v, ok := parseBorder(value)
+		if ok {
+			s.BorderRight = v
+		}
+		return ok
+	case "border-top":
+		v, ok := parseBorder(value)
+		if ok {
+			s.BorderTop = v
+		}
+		return ok
+	case "border-color":
+		s.BorderColor = value
+		return true
+	case "border-radius":
+		v, ok := parseSize(value)
+		if ok {
+			s.BorderRadius = v
+		}
+		return ok
+	case "bottom":
+		v, ok := parseSize(value)
+		if ok {
+			s.Bottom = v
+		}
+		return ok
+	case "box-shadow":
+		v, ok := parseBoxShadow(value)
+		if ok {
+			s.BoxShadow = v
+		}
+		return ok
+	case "box-sizing":
+		v, ok := parseBoxSizingType(strings.ToLower(value))
+		if ok {
+			s.BoxSizing = v
+		}
+		return ok
+	case "color":
+		s.Color = value
+		return true
+	case "cursor":
+		v, ok := parseCursorType(strings.ToLower(value))
+		if ok {
+			s.Cursor = v
+		}
+		return ok
+	case "display":
+		v, ok := parseDisplayType(strings.ToLower(value))
+		if ok {
+			s.Display = v
+		}
+		return ok
+	case "flex-basis":
+		s.FlexBasis = value
+		return true
+	case "flex-direction":
+		v, ok := parseFlexDirectionType(strings.ToLower(value))
+		if ok {
+			s.FlexDirection = v
+		}
+		return ok
+	case "flex-grow":
+		s.FlexGrow = value
+		return true
+	case "flex-shrink":
+		s.FlexShrink = value
+		return true
+	case "flex-wrap":
+		v, ok := parseFlexWrapType(strings.ToLower(value))
+		if ok {
+			s.FlexWrap = v
+		}
+		return ok
+	case "font-family":
+		s.FontFamily = value
+		return true
+	case "font-size":
+		v, ok := parseSize(value)
+		if ok {
+			s.FontSize = v
+		}
+		return ok
+	case "font-style":
+		v, ok := parseFontStyleType(strings.ToLower(value))
+		if ok {
+			s.FontStyle = v
+		}
+		return ok
+	case "font-weight":
+		s.FontWeight = value
+		return true
+	case "gap":
+		v, ok := parseSize(value)
+		if ok {
+			s.Gap = v
+		}
+		return ok
+	case "column-gap":
+		v, ok := parseSize(value)
+		if ok {
+			s.ColumnGap = v
+		}
+		return ok
+	case "row-gap":
+		v, ok := parseSize(value)
+		if ok {
+			s.RowGap = v
+		}
+		return ok
+	case "grid-area":
+		s.GridArea = value
+		return true
+	case "grid-auto-columns":
+		s.GridAutoColumns = TrackList(value)
+		return true
+	case "grid-auto-flow":
+		v, ok := parseGridAutoFlowType(strings.ToLower(value))
+		if ok {
+			s.GridAutoFlow = v
+		}
+		return ok
+	case "grid-auto-rows":
+		s.GridAutoRows = TrackList(value)
+		return true
+	case "grid-column":
+		s.GridColumn = GridLine(value)
+		return true
+	case "grid-row":
+		s.GridRow = GridLine(value)
+		return true
+	case "grid-template-areas":
+		s.GridTemplateAreas = GridAreas(value)
+		return true
+	case "grid-template-columns":
+		s.GridTemplateColumns = TrackList(value)
+		return true
+	case "grid-template-rows":
+		s.GridTemplateRows = TrackList(value)
+		return true
+	case "height":
+		v, ok := parseSize(value)
+		if ok {
+			s.Height = v
+		}
+		return ok
+	case "justify-content":
+		v, ok := parseJustifyContentType(strings.ToLower(value))
+		if ok {
+			s.JustifyContent = v
+		}
+		return ok
+	case "justify-items":
+		v, ok := parseJustifyItemsType(strings.ToLower(value))
+		if ok {
+			s.JustifyItems = v
+		}
+		return ok
+	case "justify-self":
+		v, ok := parseJustifySelfType(strings.ToLower(value))
+		if ok {
+			s.JustifySelf = v
+		}
+		return ok
+	case "left":
+		v, ok := parseSize(value)
+		if ok {
+			s.Left = v
+		}
+		return ok
+	case "letter-spacing":
+		v, ok := parseSize(value)
+		if ok {
+			s.LetterSpacing = v
+		}
+		return ok
+	case "line-height":
+		s.LineHeight = value
+		return true
+	case "margin":
+		v, ok := parseSize(value)
+		if ok {
+			s.Margin = v
+		}
+		return ok
+	case "margin-bottom":
+		v, ok := parseSize(value)
+		if ok {
+			s.MarginBottom = v
+		}
+		return ok
+	case "margin-left":
+		v, ok := parseSize(value)
+		if ok {
+			s.MarginLeft = v
+		}
+		return ok
+	case "margin-right":
+		v, ok := parseSize(value)
+		if ok {
+			s.MarginRight = v
+		}
+		return ok
+	case "margin-top":
+		v, ok := parseSize(value)
+		if ok {
+			s.MarginTop = v
+		}
+		return ok
+	case "max-height":
+		v, ok := parseSize(value)
+		if ok {
+			s.MaxHeight = v
+		}
+		return ok
+	case "max-width":
+		v, ok := parseSize(value)
+		if ok {
+			s.MaxWidth = v
+		}
+		return ok
+	case "min-height":
+		v, ok := parseSize(value)
+		if ok {
+			s.MinHeight = v
+		}
+		return ok
+	case "min-width":
+		v, ok := parseSize(value)
+		if ok {
+			s.MinWidth = v
+		}
+		return ok
+	case "object-fit":
+		v, ok := parseObjectFitType(strings.ToLower(value))
+		if ok {
+			s.ObjectFit = v
+		}
+		return ok
+	case "opacity":
+		s.Opacity = value
+		return true
+	case "order":
+		s.Order = value
+		return true
+	case "outline":
+		v, ok := parseOutline(value)
+		if ok {
+			s.Outline = v
+		}
+		return ok
+	case "overflow":
+		v, ok := parseOverflowType(strings.ToLower(value))
+		if ok {
+			s.Overflow = v
+		}
+		return ok
+	case "overflow-x":
+		v, ok := parseOverflowType(strings.ToLower(value))
+		if ok {
+			s.OverflowX = v
+		}
+		return ok
+	case "overflow-y":
+		v, ok := parseOverflowType(strings.ToLower(value))
+		if ok {
+			s.OverflowY = v
+		}
+		return ok
+	case "padding":
+		v, ok := parseSize(value)
+		if ok {
+			s.Padding = v
+		}
+		return ok
+	case "padding-bottom":
+		v, ok := parseSize(value)
+		if ok {
+			s.PaddingBottom = v
+		}
+		return ok
+	case "padding-left":
+		v, ok := parseSize(value)
+		if ok {
+			s.PaddingLeft = v
+		}
+		return ok
+	case "padding-right":
+		v, ok := parseSize(value)
+		if ok {
+			s.PaddingRight = v
+		}
+		return ok
+	case "padding-top":
+		v, ok := parseSize(value)
+		if ok {
+			s.PaddingTop = v
+		}
+		return ok
+	case "pointer-events":
+		v, ok := parsePointerEventsType(strings.ToLower(value))
+		if ok {
+			s.PointerEvents = v
+		}
+		return ok
+	case "position":
+		v, ok := parsePositionType(strings.ToLower(value))
+		if ok {
+			s.Position = v
+		}
+		return ok
+	case "right":
+		v, ok := parseSize(value)
+		if ok {
+			s.Right = v
+		}
+		return ok
+	case "text-align":
+		v, ok := parseTextAlignType(strings.ToLower(value))
+		if ok {
+			s.TextAlign = v
+		}
+		return ok
+	case "text-decoration":
+		v, ok := parseTextDecorationType(strings.ToLower(value))
+		if ok {
+			s.TextDecoration = v
+		}
+		return ok
+	case "text-overflow":
+		v, ok := parseTextOverflowType(strings.ToLower(value))
+		if ok {
+			s.TextOverflow = v
+		}
+		return ok
+	case "text-transform":
+		v, ok := parseTextTransformType(strings.ToLower(value))
+		if ok {
+			s.TextTransform = v
+		}
+		return ok
+	case "top":
+		v, ok := parseSize(value)
+		if ok {
+			s.Top = v
+		}
+		return ok
+	case "transform":
+		s.Transform = Transform(value)
+		return true
+	case "transition":
+		s.Transition = TransitionList(value)
+		return true
+	case "user-select", "-webkit-user-select", "-moz-user-select", "-ms-user-select":
+		s.UserSelect = value
+		return true
+	case "vertical-align":
+		v, ok := parseVerticalAlignType(strings.ToLower(value))
+		if ok {
+			s.VerticalAlign = v
+		}
+		return ok
+	case "visibility":
+		v, ok := parseVisibilityType(strings.ToLower(value))
+		if ok {
+			s.Visibility = v
+		}
+		return ok
+	case "white-space":
+		v, ok := parseWhiteSpaceType(strings.ToLower(value))
+		if ok {
+			s.WhiteSpace = v
+		}
+		return ok
+	case "width":
+		v, ok := parseSize(value)
+		if ok {
+			s.Width = v
+		}
+		return ok
+	case "word-break":
+		v, ok := parseWordBreakType(strings.ToLower(value))
+		if ok {
+			s.WordBreak = v
+		}
+		return ok
+	case "z-index":
+		s.ZIndex = value
+		return true
+	}
+
+	return false
+}
+
+// }}}
+
+// Style Builders (e.g., Width, WidthPX, DisplayFlex) {{{
+
+func (n *Node) AlignItems(v AlignItemsType) *Node { n.Style.AlignItems = v; return n }
+func (n *Node) AlignItemsCenter() *Node           { return n.AlignItems(AlignItemsCenter) }
+func (n *Node) AlignItemsFlexStart() *Node        { return n.AlignItems(AlignItemsFlexStart) }
+func (n *Node) AlignItemsFlexEnd() *Node          { return n.AlignItems(AlignItemsFlexEnd) }
+func (n *Node) AlignItemsStretch() *Node          { return n.AlignItems(AlignItemsStretch) }
+func (n *Node) AlignItemsBaseline() *Node         { return n.AlignItems(AlignItemsBaseline) }
+func (n *Node) AlignItemsStart() *Node            { return n.AlignItems(AlignItemsStart) }
+func (n *Node) AlignItemsEnd() *Node              { return n.AlignItems(AlignItemsEnd) }
+
+func (n *Node) AlignSelf(v AlignSelfType) *Node { n.Style.AlignSelf = v; return n }
+func (n *Node) AlignSelfAuto() *Node            { return n.AlignSelf(AlignSelfAuto) }
+func (n *Node) AlignSelfCenter() *Node          { return n.AlignSelf(AlignSelfCenter) }
+func (n *Node) AlignSelfFlexStart() *Node       { return n.AlignSelf(AlignSelfFlexStart) }
+func (n *Node) AlignSelfFlexEnd() *Node         { return n.AlignSelf(AlignSelfFlexEnd) }
+func (n *Node) AlignSelfStretch() *Node         { return n.AlignSelf(AlignSelfStretch) }
+func (n *Node) AlignSelfBaseline() *Node        { return n.AlignSelf(AlignSelfBaseline) }
+func (n *Node) AlignSelfStart() *Node           { return n.AlignSelf(AlignSelfStart) }
+func (n *Node) AlignSelfEnd() *Node             { return n.AlignSelf(AlignSelfEnd) }
+
+func (n *Node) Animation(v Animation) *Node { n.Style.Animation = v; return n }
+
+func (n *Node) Background(v string) *Node { n.Style.Background = v; return n }
+
+func (n *Node) BackgroundColor(v string) *Node  { n.Style.BackgroundColor = v; return n }
+func (n *Node) BackgroundColorOf(c Color) *Node { return n.BackgroundColor(c.String()) }
+
+func (n *Node) BackgroundImage(v string) *Node { n.Style.BackgroundImage = v; return n }
+
+func (n *Node) BackgroundPosition(v string) *Node { n.Style.BackgroundPosition = v; return n }
+
+func (n *Node) BackgroundRepeat(v string) *Node { n.Style.BackgroundRepeat = v; return n }
+
+func (n *Node) BackgroundSize(v string) *Node { n.Style.BackgroundSize = v; return n }
+
+func (n *Node) Border(v Border) *Node { n.Style.Border = v; return n }
+
+func (n *Node) BorderBottom(v Border) *Node { n.Style.BorderBottom = v; return n }
+
+func (n *Node) BorderLeft(v Border) *Node { n.Style.BorderLeft = v; return n }
+
+func (n *Node) BorderRight(v Border) *Node { n.Style.BorderRight = v; return n }
+
+func (n *Node) BorderTop(v Border) *Node { n.Style.BorderTop = v; return n }
+
+func (n *Node) BorderColor(v string) *Node  { n.Style.BorderColor = v; return n }
+func (n *Node) BorderColorOf(c Color) *Node { return n.BorderColor(c.String()) }
+
+func (n *Node) BorderRadius(v Size) *Node       { n.Style.BorderRadius = v; return n }
+func (n *Node) BorderRadiusPX(v float64) *Node  { return n.BorderRadius(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) BorderRadiusEM(v float64) *Node  { return n.BorderRadius(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) BorderRadiusREM(v float64) *Node { return n.BorderRadius(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) BorderRadiusPG(v float64) *Node  { return n.BorderRadius(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) BorderRadiusVH(v float64) *Node  { return n.BorderRadius(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) BorderRadiusVW(v float64) *Node  { return n.BorderRadius(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) Bottom(v Size) *Node       { n.Style.Bottom = v; return n }
+func (n *Node) BottomPX(v float64) *Node  { return n.Bottom(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) BottomEM(v float64) *Node  { return n.Bottom(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) BottomREM(v float64) *Node { return n.Bottom(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) BottomPG(v float64) *Node  { return n.Bottom(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) BottomVH(v float64) *Node  { return n.Bottom(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) BottomVW(v float64) *Node  { return n.Bottom(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) BoxShadow(v BoxShadow) *Node { n.Style.BoxShadow = v; return n }
+
+func (n *Node) BoxSizing(v BoxSizingType) *Node { n.Style.BoxSizing = v; return n }
+func (n *Node) BoxSizingBorderBox() *Node       { return n.BoxSizing(BoxSizingBorderBox) }
+func (n *Node) BoxSizingContentBox() *Node      { return n.BoxSizing(BoxSizingContentBox) }
+
+func (n *Node) Color(v string) *Node  { n.Style.Color = v; return n }
+func (n *Node) ColorOf(c Color) *Node { return n.Color(c.String()) }
+
+func (n *Node) Cursor(v CursorType) *Node { n.Style.Cursor = v; return n }
+func (n *Node) CursorDefault() *Node      { return n.Cursor(CursorDefault) }
+func (n *Node) CursorPointer() *Node      { return n.Cursor(CursorPointer) }
+func (n *Node) CursorMove() *Node         { return n.Cursor(CursorMove) }
+func (n *Node) CursorNWSEResize() *Node   { return n.Cursor(CursorNWSEResize) }
+func (n *Node) CursorEWResize() *Node     { return n.Cursor(CursorEWResize) }
+func (n *Node) CursorNSResize() *Node     { return n.Cursor(CursorNSResize) }
+func (n *Node) CursorText() *Node         { return n.Cursor(CursorText) }
+func (n *Node) CursorNotAllowed() *Node   { return n.Cursor(CursorNotAllowed) }
+func (n *Node) CursorGrab() *Node         { return n.Cursor(CursorGrab) }
+func (n *Node) CursorGrabbing() *Node     { return n.Cursor(CursorGrabbing) }
+func (n *Node) CursorCrosshair() *Node    { return n.Cursor(CursorCrosshair) }
+func (n *Node) CursorWait() *Node         { return n.Cursor(CursorWait) }
+
+func (n *Node) Display(v DisplayType) *Node { n.Style.Display = v; return n }
+func (n *Node) DisplayNone() *Node          { return n.Display(DisplayNone) }
+func (n *Node) DisplayFlex() *Node          { return n.Display(DisplayFlex) }
+func (n *Node) DisplayGrid() *Node          { return n.Display(DisplayGrid) }
+func (n *Node) DisplayBlock() *Node         { return n.Display(DisplayBlock) }
+func (n *Node) DisplayInline() *Node        { return n.Display(DisplayInline) }
+func (n *Node) DisplayInlineBlock() *Node   { return n.Display(DisplayInlineBlock) }
+func (n *Node) DisplayInlineFlex() *Node    { return n.Display(DisplayInlineFlex) }
+func (n *Node) DisplayInlineGrid() *Node    { return n.Display(DisplayInlineGrid) }
+func (n *Node) DisplayContents() *Node      { return n.Display(DisplayContents) }
+
+func (n *Node) FlexBasis(v string) *Node { n.Style.FlexBasis = v; return n }
+
+func (n *Node) FlexDirection(v FlexDirectionType) *Node { n.Style.FlexDirection = v; return n }
+func (n *Node) FlexDirectionRow() *Node                 { return n.FlexDirection(FlexDirectionRow) }
+func (n *Node) FlexDirectionColumn() *Node              { return n.FlexDirection(FlexDirectionColumn) }
+func (n *Node) FlexDirectionRowReverse() *Node          { return n.FlexDirection(FlexDirectionRowReverse) }
+func (n *Node) FlexDirectionColumnReverse() *Node       { return n.FlexDirection(FlexDirectionColumnReverse) }
+
+func (n *Node) FlexGrow(v string) *Node { n.Style.FlexGrow = v; return n }
+
+func (n *Node) FlexShrink(v string) *Node { n.Style.FlexShrink = v; return n }
+
+func (n *Node) FlexWrap(v FlexWrapType) *Node { n.Style.FlexWrap = v; return n }
+func (n *Node) FlexWrapWrap() *Node           { return n.FlexWrap(FlexWrapWrap) }
+func (n *Node) FlexWrapNoWrap() *Node         { return n.FlexWrap(FlexWrapNoWrap) }
+func (n *Node) FlexWrapWrapReverse() *Node    { return n.FlexWrap(FlexWrapWrapReverse) }
+
+func (n *Node) FontFamily(v string) *Node { n.Style.FontFamily = v; return n }
+
+func (n *Node) FontSize(v Size) *Node       { n.Style.FontSize = v; return n }
+func (n *Node) FontSizePX(v float64) *Node  { return n.FontSize(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) FontSizeEM(v float64) *Node  { return n.FontSize(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) FontSizeREM(v float64) *Node { return n.FontSize(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) FontSizePG(v float64) *Node  { return n.FontSize(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) FontSizeVH(v float64) *Node  { return n.FontSize(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) FontSizeVW(v float64) *Node  { return n.FontSize(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) FontStyle(v FontStyleType) *Node { n.Style.FontStyle = v; return n }
+func (n *Node) FontStyleNormal() *Node          { return n.FontStyle(FontStyleNormal) }
+func (n *Node) FontStyleItalic() *Node          { return n.FontStyle(FontStyleItalic) }
+func (n *Node) FontStyleOblique() *Node         { return n.FontStyle(FontStyleOblique) }
+
+func (n *Node) FontWeight(v string) *Node { n.Style.FontWeight = v; return n }
+
+func (n *Node) Gap(v Size) *Node       { n.Style.Gap = v; return n }
+func (n *Node) GapPX(v float64) *Node  { return n.Gap(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) GapEM(v float64) *Node  { return n.Gap(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) GapREM(v float64) *Node { return n.Gap(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) GapPG(v float64) *Node  { return n.Gap(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) GapVH(v float64) *Node  { return n.Gap(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) GapVW(v float64) *Node  { return n.Gap(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) ColumnGap(v Size) *Node       { n.Style.ColumnGap = v; return n }
+func (n *Node) ColumnGapPX(v float64) *Node  { return n.ColumnGap(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) ColumnGapEM(v float64) *Node  { return n.ColumnGap(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) ColumnGapREM(v float64) *Node { return n.ColumnGap(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) ColumnGapPG(v float64) *Node  { return n.ColumnGap(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) ColumnGapVH(v float64) *Node  { return n.ColumnGap(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) ColumnGapVW(v float64) *Node  { return n.ColumnGap(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) RowGap(v Size) *Node       { n.Style.RowGap = v; return n }
+func (n *Node) RowGapPX(v float64) *Node  { return n.RowGap(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) RowGapEM(v float64) *Node  { return n.RowGap(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) RowGapREM(v float64) *Node { return n.RowGap(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) RowGapPG(v float64) *Node  { return n.RowGap(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) RowGapVH(v float64) *Node  { return n.RowGap(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) RowGapVW(v float64) *Node  { return n.RowGap(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) GridArea(v string) *Node { n.Style.GridArea = v; return n }
+
+func (n *Node) GridAutoColumns(v TrackList) *Node { n.Style.GridAutoColumns = v; return n }
+
+func (n *Node) GridAutoFlow(v GridAutoFlowType) *Node { n.Style.GridAutoFlow = v; return n }
+func (n *Node) GridAutoFlowRow() *Node                { return n.GridAutoFlow(GridAutoFlowRow) }
+func (n *Node) GridAutoFlowColumn() *Node             { return n.GridAutoFlow(GridAutoFlowColumn) }
+func (n *Node) GridAutoFlowDense() *Node              { return n.GridAutoFlow(GridAutoFlowDense) }
+func (n *Node) GridAutoFlowRowDense() *Node           { return n.GridAutoFlow(GridAutoFlowRowDense) }
+func (n *Node) GridAutoFlowColumnDense() *Node        { return n.GridAutoFlow(GridAutoFlowColumnDense) }
+
+func (n *Node) GridAutoRows(v TrackList) *Node { n.Style.GridAutoRows = v; return n }
+
+func (n *Node) GridColumn(v GridLine) *Node { n.Style.GridColumn = v; return n }
+
+func (n *Node) GridRow(v GridLine) *Node { n.Style.GridRow = v; return n }
+
+func (n *Node) GridTemplateAreas(v GridAreas) *Node { n.Style.GridTemplateAreas = v; return n }
+
+func (n *Node) GridTemplateColumns(v TrackList) *Node { n.Style.GridTemplateColumns = v; return n }
+
+func (n *Node) GridTemplateRows(v TrackList) *Node { n.Style.GridTemplateRows = v; return n }
+
+func (n *Node) Height(v Size) *Node       { n.Style.Height = v; return n }
+func (n *Node) HeightPX(v float64) *Node  { return n.Height(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) HeightEM(v float64) *Node  { return n.Height(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) HeightREM(v float64) *Node { return n.Height(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) HeightPG(v float64) *Node  { return n.Height(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) HeightVH(v float64) *Node  { return n.Height(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) HeightVW(v float64) *Node  { return n.Height(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) JustifyContent(v JustifyContentType) *Node { n.Style.JustifyContent = v; return n }
+func (n *Node) JustifyContentCenter() *Node               { return n.JustifyContent(JustifyContentCenter) }
+func (n *Node) JustifyContentSpaceBetween() *Node {
+	return n.JustifyContent(JustifyContentSpaceBetween)
+}
+func (n *Node) JustifyContentFlexEnd() *Node     { return n.JustifyContent(JustifyContentFlexEnd) }
+func (n *Node) JustifyContentFlexStart() *Node   { return n.JustifyContent(JustifyContentFlexStart) }
+func (n *Node) JustifyContentSpaceAround() *Node { return n.JustifyContent(JustifyContentSpaceAround) }
+func (n *Node) JustifyContentSpaceEvenly() *Node { return n.JustifyContent(JustifyContentSpaceEvenly) }
+func (n *Node) JustifyContentStart() *Node       { return n.JustifyContent(JustifyContentStart) }
+func (n *Node) JustifyContentEnd() *Node         { return n.JustifyContent(JustifyContentEnd) }
+
+func (n *Node) JustifyItems(v JustifyItemsType) *Node { n.Style.JustifyItems = v; return n }
+func (n *Node) JustifyItemsStart() *Node              { return n.JustifyItems(JustifyItemsStart) }
+func (n *Node) JustifyItemsEnd() *Node                { return n.JustifyItems(JustifyItemsEnd) }
+func (n *Node) JustifyItemsCenter() *Node             { return n.JustifyItems(JustifyItemsCenter) }
+func (n *Node) JustifyItemsStretch() *Node            { return n.JustifyItems(JustifyItemsStretch) }
+func (n *Node) JustifyItemsBaseline() *Node           { return n.JustifyItems(JustifyItemsBaseline) }
+
+func (n *Node) JustifySelf(v JustifySelfType) *Node { n.Style.JustifySelf = v; return n }
+func (n *Node) JustifySelfRight() *Node             { return n.JustifySelf(JustifySelfRight) }
+func (n *Node) JustifySelfLeft() *Node              { return n.JustifySelf(JustifySelfLeft) }
+func (n *Node) JustifySelfFlexEnd() *Node           { return n.JustifySelf(JustifySelfFlexEnd) }
+func (n *Node) JustifySelfFlexStart() *Node         { return n.JustifySelf(JustifySelfFlexStart) }
+func (n *Node) JustifySelfCenter() *Node            { return n.JustifySelf(JustifySelfCenter) }
+func (n *Node) JustifySelfStart() *Node             { return n.JustifySelf(JustifySelfStart) }
+func (n *Node) JustifySelfEnd() *Node               { return n.JustifySelf(JustifySelfEnd) }
+func (n *Node) JustifySelfStretch() *Node           { return n.JustifySelf(JustifySelfStretch) }
+
+func (n *Node) Left(v Size) *Node       { n.Style.Left = v; return n }
+func (n *Node) LeftPX(v float64) *Node  { return n.Left(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) LeftEM(v float64) *Node  { return n.Left(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) LeftREM(v float64) *Node { return n.Left(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) LeftPG(v float64) *Node  { return n.Left(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) LeftVH(v float64) *Node  { return n.Left(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) LeftVW(v float64) *Node  { return n.Left(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) LetterSpacing(v Size) *Node      { n.Style.LetterSpacing = v; return n }
+func (n *Node) LetterSpacingPX(v float64) *Node { return n.LetterSpacing(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) LetterSpacingEM(v float64) *Node { return n.LetterSpacing(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) LetterSpacingREM(v float64) *Node {
+	return n.LetterSpacing(Size{Value: v, Unit: UnitREM})
+}
+func (n *Node) LetterSpacingPG(v float64) *Node { return n.LetterSpacing(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) LetterSpacingVH(v float64) *Node { return n.LetterSpacing(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) LetterSpacingVW(v float64) *Node { return n.LetterSpacing(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) LineHeight(v string) *Node { n.Style.LineHeight = v; return n }
+
+func (n *Node) Margin(v Size) *Node       { n.Style.Margin = v; return n }
+func (n *Node) MarginPX(v float64) *Node  { return n.Margin(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MarginEM(v float64) *Node  { return n.Margin(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MarginREM(v float64) *Node { return n.Margin(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MarginPG(v float64) *Node  { return n.Margin(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MarginVH(v float64) *Node  { return n.Margin(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MarginVW(v float64) *Node  { return n.Margin(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MarginBottom(v Size) *Node       { n.Style.MarginBottom = v; return n }
+func (n *Node) MarginBottomPX(v float64) *Node  { return n.MarginBottom(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MarginBottomEM(v float64) *Node  { return n.MarginBottom(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MarginBottomREM(v float64) *Node { return n.MarginBottom(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MarginBottomPG(v float64) *Node  { return n.MarginBottom(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MarginBottomVH(v float64) *Node  { return n.MarginBottom(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MarginBottomVW(v float64) *Node  { return n.MarginBottom(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MarginLeft(v Size) *Node       { n.Style.MarginLeft = v; return n }
+func (n *Node) MarginLeftPX(v float64) *Node  { return n.MarginLeft(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MarginLeftEM(v float64) *Node  { return n.MarginLeft(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MarginLeftREM(v float64) *Node { return n.MarginLeft(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MarginLeftPG(v float64) *Node  { return n.MarginLeft(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MarginLeftVH(v float64) *Node  { return n.MarginLeft(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MarginLeftVW(v float64) *Node  { return n.MarginLeft(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MarginRight(v Size) *Node       { n.Style.MarginRight = v; return n }
+func (n *Node) MarginRightPX(v float64) *Node  { return n.MarginRight(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MarginRightEM(v float64) *Node  { return n.MarginRight(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MarginRightREM(v float64) *Node { return n.MarginRight(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MarginRightPG(v float64) *Node  { return n.MarginRight(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MarginRightVH(v float64) *Node  { return n.MarginRight(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MarginRightVW(v float64) *Node  { return n.MarginRight(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MarginTop(v Size) *Node       { n.Style.MarginTop = v; return n }
+func (n *Node) MarginTopPX(v float64) *Node  { return n.MarginTop(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MarginTopEM(v float64) *Node  { return n.MarginTop(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MarginTopREM(v float64) *Node { return n.MarginTop(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MarginTopPG(v float64) *Node  { return n.MarginTop(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MarginTopVH(v float64) *Node  { return n.MarginTop(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MarginTopVW(v float64) *Node  { return n.MarginTop(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MaxHeight(v Size) *Node       { n.Style.MaxHeight = v; return n }
+func (n *Node) MaxHeightPX(v float64) *Node  { return n.MaxHeight(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MaxHeightEM(v float64) *Node  { return n.MaxHeight(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MaxHeightREM(v float64) *Node { return n.MaxHeight(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MaxHeightPG(v float64) *Node  { return n.MaxHeight(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MaxHeightVH(v float64) *Node  { return n.MaxHeight(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MaxHeightVW(v float64) *Node  { return n.MaxHeight(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MaxWidth(v Size) *Node       { n.Style.MaxWidth = v; return n }
+func (n *Node) MaxWidthPX(v float64) *Node  { return n.MaxWidth(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MaxWidthEM(v float64) *Node  { return n.MaxWidth(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MaxWidthREM(v float64) *Node { return n.MaxWidth(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MaxWidthPG(v float64) *Node  { return n.MaxWidth(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MaxWidthVH(v float64) *Node  { return n.MaxWidth(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MaxWidthVW(v float64) *Node  { return n.MaxWidth(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MinHeight(v Size) *Node       { n.Style.MinHeight = v; return n }
+func (n *Node) MinHeightPX(v float64) *Node  { return n.MinHeight(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MinHeightEM(v float64) *Node  { return n.MinHeight(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MinHeightREM(v float64) *Node { return n.MinHeight(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MinHeightPG(v float64) *Node  { return n.MinHeight(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MinHeightVH(v float64) *Node  { return n.MinHeight(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MinHeightVW(v float64) *Node  { return n.MinHeight(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) MinWidth(v Size) *Node       { n.Style.MinWidth = v; return n }
+func (n *Node) MinWidthPX(v float64) *Node  { return n.MinWidth(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) MinWidthEM(v float64) *Node  { return n.MinWidth(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) MinWidthREM(v float64) *Node { return n.MinWidth(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) MinWidthPG(v float64) *Node  { return n.MinWidth(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) MinWidthVH(v float64) *Node  { return n.MinWidth(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) MinWidthVW(v float64) *Node  { return n.MinWidth(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) ObjectFit(v ObjectFitType) *Node { n.Style.ObjectFit = v; return n }
+func (n *Node) ObjectFitFill() *Node            { return n.ObjectFit(ObjectFitFill) }
+func (n *Node) ObjectFitContain() *Node         { return n.ObjectFit(ObjectFitContain) }
+func (n *Node) ObjectFitCover() *Node           { return n.ObjectFit(ObjectFitCover) }
+func (n *Node) ObjectFitNone() *Node            { return n.ObjectFit(ObjectFitNone) }
+func (n *Node) ObjectFitScaleDown() *Node       { return n.ObjectFit(ObjectFitScaleDown) }
+
+func (n *Node) Opacity(v string) *Node { n.Style.Opacity = v; return n }
+
+func (n *Node) Order(v string) *Node { n.Style.Order = v; return n }
+
+func (n *Node) Outline(v Outline) *Node { n.Style.Outline = v; return n }
+
+func (n *Node) Overflow(v OverflowType) *Node { n.Style.Overflow = v; return n }
+func (n *Node) OverflowHidden() *Node         { return n.Overflow(OverflowHidden) }
+func (n *Node) OverflowScroll() *Node         { return n.Overflow(OverflowScroll) }
+func (n *Node) OverflowAuto() *Node           { return n.Overflow(OverflowAuto) }
+func (n *Node) OverflowVisible() *Node        { return n.Overflow(OverflowVisible) }
+
+func (n *Node) OverflowX(v OverflowType) *Node { n.Style.OverflowX = v; return n }
+func (n *Node) OverflowXHidden() *Node         { return n.OverflowX(OverflowHidden) }
+func (n *Node) OverflowXScroll() *Node         { return n.OverflowX(OverflowScroll) }
+func (n *Node) OverflowXAuto() *Node           { return n.OverflowX(OverflowAuto) }
+func (n *Node) OverflowXVisible() *Node        { return n.OverflowX(OverflowVisible) }
+
+func (n *Node) OverflowY(v OverflowType) *Node { n.Style.OverflowY = v; return n }
+func (n *Node) OverflowYHidden() *Node         { return n.OverflowY(OverflowHidden) }
+func (n *Node) OverflowYScroll() *Node         { return n.OverflowY(OverflowScroll) }
+func (n *Node) OverflowYAuto() *Node           { return n.OverflowY(OverflowAuto) }
+func (n *Node) OverflowYVisible() *Node        { return n.OverflowY(OverflowVisible) }
+
+func (n *Node) Padding(v Size) *Node       { n.Style.Padding = v; return n }
+func (n *Node) PaddingPX(v float64) *Node  { return n.Padding(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) PaddingEM(v float64) *Node  { return n.Padding(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) PaddingREM(v float64) *Node { return n.Padding(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) PaddingPG(v float64) *Node  { return n.Padding(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) PaddingVH(v float64) *Node  { return n.Padding(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) PaddingVW(v float64) *Node  { return n.Padding(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) PaddingBottom(v Size) *Node      { n.Style.PaddingBottom = v; return n }
+func (n *Node) PaddingBottomPX(v float64) *Node { return n.PaddingBottom(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) PaddingBottomEM(v float64) *Node { return n.PaddingBottom(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) PaddingBottomREM(v float64) *Node {
+	return n.PaddingBottom(Size{Value: v, Unit: UnitREM})
+}
+func (n *Node) PaddingBottomPG(v float64) *Node { return n.PaddingBottom(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) PaddingBottomVH(v float64) *Node { return n.PaddingBottom(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) PaddingBottomVW(v float64) *Node { return n.PaddingBottom(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) PaddingLeft(v Size) *Node       { n.Style.PaddingLeft = v; return n }
+func (n *Node) PaddingLeftPX(v float64) *Node  { return n.PaddingLeft(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) PaddingLeftEM(v float64) *Node  { return n.PaddingLeft(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) PaddingLeftREM(v float64) *Node { return n.PaddingLeft(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) PaddingLeftPG(v float64) *Node  { return n.PaddingLeft(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) PaddingLeftVH(v float64) *Node  { return n.PaddingLeft(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) PaddingLeftVW(v float64) *Node  { return n.PaddingLeft(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) PaddingRight(v Size) *Node       { n.Style.PaddingRight = v; return n }
+func (n *Node) PaddingRightPX(v float64) *Node  { return n.PaddingRight(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) PaddingRightEM(v float64) *Node  { return n.PaddingRight(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) PaddingRightREM(v float64) *Node { return n.PaddingRight(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) PaddingRightPG(v float64) *Node  { return n.PaddingRight(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) PaddingRightVH(v float64) *Node  { return n.PaddingRight(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) PaddingRightVW(v float64) *Node  { return n.PaddingRight(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) PaddingTop(v Size) *Node       { n.Style.PaddingTop = v; return n }
+func (n *Node) PaddingTopPX(v float64) *Node  { return n.PaddingTop(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) PaddingTopEM(v float64) *Node  { return n.PaddingTop(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) PaddingTopREM(v float64) *Node { return n.PaddingTop(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) PaddingTopPG(v float64) *Node  { return n.PaddingTop(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) PaddingTopVH(v float64) *Node  { return n.PaddingTop(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) PaddingTopVW(v float64) *Node  { return n.PaddingTop(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) PointerEvents(v PointerEventsType) *Node { n.Style.PointerEvents = v; return n }
+func (n *Node) PointerEventsAuto() *Node                { return n.PointerEvents(PointerEventsAuto) }
+func (n *Node) PointerEventsNone() *Node                { return n.PointerEvents(PointerEventsNone) }
+
+func (n *Node) Position(v PositionType) *Node { n.Style.Position = v; return n }
+func (n *Node) PositionRelative() *Node       { return n.Position(PositionRelative) }
+func (n *Node) PositionAbsolute() *Node       { return n.Position(PositionAbsolute) }
+func (n *Node) PositionFixed() *Node          { return n.Position(PositionFixed) }
+func (n *Node) PositionSticky() *Node         { return n.Position(PositionSticky) }
+func (n *Node) PositionStatic() *Node         { return n.Position(PositionStatic) }
+
+func (n *Node) Right(v Size) *Node       { n.Style.Right = v; return n }
+func (n *Node) RightPX(v float64) *Node  { return n.Right(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) RightEM(v float64) *Node  { return n.Right(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) RightREM(v float64) *Node { return n.Right(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) RightPG(v float64) *Node  { return n.Right(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) RightVH(v float64) *Node  { return n.Right(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) RightVW(v float64) *Node  { return n.Right(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) TextAlign(v TextAlignType) *Node { n.Style.TextAlign = v; return n }
+func (n *Node) TextAlignCenter() *Node          { return n.TextAlign(TextAlignCenter) }
+func (n *Node) TextAlignRight() *Node           { return n.TextAlign(TextAlignRight) }
+func (n *Node) TextAlignLeft() *Node            { return n.TextAlign(TextAlignLeft) }
+func (n *Node) TextAlignJustify() *Node         { return n.TextAlign(TextAlignJustify) }
+
+func (n *Node) TextDecoration(v TextDecorationType) *Node { n.Style.TextDecoration = v; return n }
+func (n *Node) TextDecorationLineThrough() *Node          { return n.TextDecoration(TextDecorationLineThrough) }
+func (n *Node) TextDecorationOverline() *Node             { return n.TextDecoration(TextDecorationOverline) }
+func (n *Node) TextDecorationUnderline() *Node            { return n.TextDecoration(TextDecorationUnderline) }
+func (n *Node) TextDecorationNone() *Node                 { return n.TextDecoration(TextDecorationNone) }
+
+func (n *Node) TextOverflow(v TextOverflowType) *Node { n.Style.TextOverflow = v; return n }
+func (n *Node) TextOverflowClip() *Node               { return n.TextOverflow(TextOverflowClip) }
+func (n *Node) TextOverflowEllipsis() *Node           { return n.TextOverflow(TextOverflowEllipsis) }
+
+func (n *Node) TextTransform(v TextTransformType) *Node { n.Style.TextTransform = v; return n }
+func (n *Node) TextTransformNone() *Node                { return n.TextTransform(TextTransformNone) }
+func (n *Node) TextTransformUppercase() *Node           { return n.TextTransform(TextTransformUppercase) }
+func (n *Node) TextTransformLowercase() *Node           { return n.TextTransform(TextTransformLowercase) }
+func (n *Node) TextTransformCapitalize() *Node          { return n.TextTransform(TextTransformCapitalize) }
+
+func (n *Node) Top(v Size) *Node       { n.Style.Top = v; return n }
+func (n *Node) TopPX(v float64) *Node  { return n.Top(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) TopEM(v float64) *Node  { return n.Top(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) TopREM(v float64) *Node { return n.Top(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) TopPG(v float64) *Node  { return n.Top(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) TopVH(v float64) *Node  { return n.Top(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) TopVW(v float64) *Node  { return n.Top(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) Transform(v Transform) *Node { n.Style.Transform = v; return n }
+
+func (n *Node) Transition(v TransitionList) *Node { n.Style.Transition = v; return n }
+
+func (n *Node) UserSelect(v string) *Node { n.Style.UserSelect = v; return n }
+
+func (n *Node) VerticalAlign(v VerticalAlignType) *Node { n.Style.VerticalAlign = v; return n }
+func (n *Node) VerticalAlignBaseline() *Node            { return n.VerticalAlign(VerticalAlignBaseline) }
+func (n *Node) VerticalAlignTop() *Node                 { return n.VerticalAlign(VerticalAlignTop) }
+func (n *Node) VerticalAlignMiddle() *Node              { return n.VerticalAlign(VerticalAlignMiddle) }
+func (n *Node) VerticalAlignBottom() *Node              { return n.VerticalAlign(VerticalAlignBottom) }
+func (n *Node) VerticalAlignTextTop() *Node             { return n.VerticalAlign(VerticalAlignTextTop) }
+func (n *Node) VerticalAlignTextBottom() *Node          { return n.VerticalAlign(VerticalAlignTextBottom) }
+
+func (n *Node) Visibility(v VisibilityType) *Node { n.Style.Visibility = v; return n }
+func (n *Node) VisibilityVisible() *Node          { return n.Visibility(VisibilityVisible) }
+func (n *Node) VisibilityHidden() *Node           { return n.Visibility(VisibilityHidden) }
+func (n *Node) VisibilityCollapse() *Node         { return n.Visibility(VisibilityCollapse) }
+
+func (n *Node) WhiteSpace(v WhiteSpaceType) *Node { n.Style.WhiteSpace = v; return n }
+func (n *Node) WhiteSpaceNormal() *Node           { return n.WhiteSpace(WhiteSpaceNormal) }
+func (n *Node) WhiteSpaceNoWrap() *Node           { return n.WhiteSpace(WhiteSpaceNoWrap) }
+func (n *Node) WhiteSpacePre() *Node              { return n.WhiteSpace(WhiteSpacePre) }
+func (n *Node) WhiteSpacePreWrap() *Node          { return n.WhiteSpace(WhiteSpacePreWrap) }
+func (n *Node) WhiteSpacePreLine() *Node          { return n.WhiteSpace(WhiteSpacePreLine) }
+
+func (n *Node) Width(v Size) *Node       { n.Style.Width = v; return n }
+func (n *Node) WidthPX(v float64) *Node  { return n.Width(Size{Value: v, Unit: UnitPX}) }
+func (n *Node) WidthEM(v float64) *Node  { return n.Width(Size{Value: v, Unit: UnitEM}) }
+func (n *Node) WidthREM(v float64) *Node { return n.Width(Size{Value: v, Unit: UnitREM}) }
+func (n *Node) WidthPG(v float64) *Node  { return n.Width(Size{Value: v, Unit: UnitPG}) }
+func (n *Node) WidthVH(v float64) *Node  { return n.Width(Size{Value: v, Unit: UnitVH}) }
+func (n *Node) WidthVW(v float64) *Node  { return n.Width(Size{Value: v, Unit: UnitVW}) }
+
+func (n *Node) WordBreak(v WordBreakType) *Node { n.Style.WordBreak = v; return n }
+func (n *Node) WordBreakNormal() *Node          { return n.WordBreak(WordBreakNormal) }
+func (n *Node) WordBreakBreakAll() *Node        { return n.WordBreak(WordBreakBreakAll) }
+func (n *Node) WordBreakKeepAll() *Node         { return n.WordBreak(WordBreakKeepAll) }
+func (n *Node) WordBreakBreakWord() *Node       { return n.WordBreak(WordBreakBreakWord) }
+
+func (n *Node) ZIndex(v string) *Node { n.Style.ZIndex = v; return n }
+
+func (n *Node) HoverStyle(s Style) *Node        { n.Style.Hover = &s; return n }
+func (n *Node) FocusStyle(s Style) *Node        { n.Style.Focus = &s; return n }
+func (n *Node) FocusVisibleStyle(s Style) *Node { n.Style.FocusVisible = &s; return n }
+func (n *Node) FocusWithinStyle(s Style) *Node  { n.Style.FocusWithin = &s; return n }
+func (n *Node) ActiveStyle(s Style) *Node       { n.Style.Active = &s; return n }
+func (n *Node) DisabledStyle(s Style) *Node     { n.Style.Disabled = &s; return n }
+func (n *Node) PlaceholderStyle(s Style) *Node  { n.Style.Placeholder = &s; return n }
+
+// }}}
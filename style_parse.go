@@ -0,0 +1,383 @@
+package browser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A Declaration is a property and its value, e.g., {"color", "red"}.
+type Declaration struct {
+	Property, Value string
+}
+
+// ParseStyle parses the declarations of an inline style, such as
+// "display:flex;padding:4px 8px". It returns the declarations it did not
+// recognize, because Style has no field for the property, or the value
+// does not fit the field's type, or they are malformed.
+//
+// Later declarations override earlier ones, and custom properties
+// (e.g., --accent) are set in Style.Vars. A value which is not a single
+// length, like the "4px 8px" above, is kept in Size.StringOverride.
+//
+// ParseStyle inverts Val: the style parsed from s.Val() has the same Val.
+func ParseStyle(css string) (s Style, unrecognized []Declaration) {
+	for _, d := range split(css, ';') {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+
+		i := strings.IndexByte(d, ':')
+		if i < 0 {
+			unrecognized = append(unrecognized, Declaration{Property: d})
+			continue
+		}
+		name, value := strings.TrimSpace(d[:i]), strings.TrimSpace(d[i+1:])
+		if !validName(name) || value == "" || !contained(value) {
+			unrecognized = append(unrecognized, Declaration{name, value})
+			continue
+		}
+
+		if strings.HasPrefix(name, "--") { // custom properties are case sensitive
+			if s.Vars == nil {
+				s.Vars = make(map[string]string)
+			}
+			s.Vars[name] = value
+			continue
+		}
+		if !s.parseProperty(strings.ToLower(name), value) {
+			unrecognized = append(unrecognized, Declaration{name, value})
+		}
+	}
+
+	return s, unrecognized
+}
+
+// Scanning {{{
+
+// A scanner tracks whether a position in a value is inside quotes or
+// parentheses (e.g., url(data:...;base64,...)), where separators don't count.
+type scanner struct {
+	quote   byte // the open quote, if any
+	depth   int
+	escaped bool // the last byte was a backslash
+	broken  bool // a ) closed nothing
+}
+
+// next steps over c, reporting whether it is outside quotes and parentheses.
+func (s *scanner) next(c byte) (outside bool) {
+	switch {
+	case s.escaped:
+		s.escaped = false
+		return false
+	case c == '\\':
+		s.escaped = true
+		return false
+	case s.quote != 0:
+		if c == s.quote {
+			s.quote = 0
+		}
+		return false
+	case c == '"' || c == '\'':
+		s.quote = c
+		return false
+	case c == '(':
+		s.depth++
+		return false
+	case c == ')':
+		if s.depth == 0 {
+			s.broken = true
+			return true
+		}
+		s.depth--
+		return false
+	}
+
+	return s.depth == 0
+}
+
+// split splits s at each sep outside quotes and parentheses.
+func split(s string, sep byte) (parts []string) {
+	var sc scanner
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if sc.next(s[i]) && s[i] == sep {
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+// contained reports whether the value stays inside the block of a rule
+// when written to a stylesheet: it closes its quotes and parentheses,
+// and has no braces, at-signs or comments outside quotes, nor semicolons
+// outside quotes and parentheses.
+func contained(v string) bool {
+	var sc scanner
+	for i := 0; i < len(v); i++ {
+		quoted := sc.quote != 0 || sc.escaped
+		outside := sc.next(v[i])
+		if quoted {
+			continue
+		}
+		switch c := v[i]; {
+		case c == '{' || c == '}' || c == '@',
+			c == '/' && i+1 < len(v) && v[i+1] == '*',
+			c == ';' && outside:
+			return false
+		}
+	}
+
+	return sc.quote == 0 && sc.depth == 0 && !sc.escaped && !sc.broken
+}
+
+// fields splits s at runs of whitespace outside quotes and parentheses.
+func fields(s string) (fs []string) {
+	var sc scanner
+	start := -1
+	for i := 0; i < len(s); i++ {
+		space := sc.next(s[i]) && strings.IndexByte(" \t\n\r\f", s[i]) >= 0
+		switch {
+		case space && start >= 0:
+			fs = append(fs, s[start:i])
+			start = -1
+		case !space && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		fs = append(fs, s[start:])
+	}
+
+	return fs
+}
+
+func validName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " \t\n\r\f'\"()\\;:")
+}
+
+// }}}
+
+// Values {{{
+
+var unitsByName = map[string]UnitType{
+	"":    UnitDefault,
+	"em":  UnitEM,
+	"pc":  UnitPC,
+	"%":   UnitPG,
+	"pt":  UnitPT,
+	"px":  UnitPX,
+	"vh":  UnitVH,
+	"vw":  UnitVW,
+	"rem": UnitREM,
+	"fr":  UnitFR,
+}
+
+// parseLength parses a number and unit, e.g., "4px" or "0".
+func parseLength(s string) (Size, bool) {
+	i := numberPrefix(s)
+	if i == 0 {
+		return Size{}, false
+	}
+	u, ok := unitsByName[strings.ToLower(s[i:])]
+	if !ok {
+		return Size{}, false
+	}
+	v, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return Size{}, false
+	}
+
+	return Size{Value: v, Unit: u}, true
+}
+
+// numberPrefix returns the length of the CSS number s starts with, or 0.
+func numberPrefix(s string) int {
+	digits := func(i int) int {
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		return i
+	}
+
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	j := digits(i)
+	if j < len(s) && s[j] == '.' {
+		if k := digits(j + 1); k > j+1 {
+			j = k
+		}
+	}
+	if j == i { // no digits
+		return 0
+	}
+	if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+		k := j + 1
+		if k < len(s) && (s[k] == '+' || s[k] == '-') {
+			k++
+		}
+		if l := digits(k); l > k {
+			j = l
+		}
+	}
+
+	return j
+}
+
+// sizeKeywords are the keywords of the Size properties, e.g., "auto"
+// for a width, or "large" for a font-size.
+var sizeKeywords = map[string]bool{
+	"auto": true, "none": true, "normal": true,
+	"min-content": true, "max-content": true, "fit-content": true,
+	"thin": true, "medium": true, "thick": true,
+	"xx-small": true, "x-small": true, "small": true, "large": true,
+	"x-large": true, "xx-large": true, "xxx-large": true,
+	"smaller": true, "larger": true,
+	"inherit": true, "initial": true, "unset": true, "revert": true, "revert-layer": true,
+}
+
+// sizeFuncs are the functions a Size property may be set to.
+var sizeFuncs = []string{"calc(", "min(", "max(", "clamp(", "var(", "fit-content("}
+
+// parseSize parses a length, or keeps as the StringOverride a value of
+// lengths, keywords and functions, e.g., the "0 auto" of a margin or
+// "calc(100% - 4px)". Other values, e.g., "red", are not sizes.
+func parseSize(v string) (Size, bool) {
+	if s, ok := parseLength(v); ok {
+		return s, true
+	}
+
+	ts := fields(v)
+	if len(ts) == 0 {
+		return Size{}, false
+	}
+	for _, t := range ts {
+		if !sizeTerm(t) {
+			return Size{}, false
+		}
+	}
+	return Size{StringOverride: v}, true
+}
+
+// sizeTerm reports whether the token is a length, a keyword or a
+// function of a Size, or the "/" of a border-radius.
+func sizeTerm(t string) bool {
+	if _, ok := parseLength(t); ok || t == "/" {
+		return true
+	}
+	l := strings.ToLower(t)
+	if sizeKeywords[l] {
+		return true
+	}
+	for _, f := range sizeFuncs {
+		if strings.HasPrefix(l, f) && strings.HasSuffix(l, ")") {
+			return true
+		}
+	}
+	return false
+}
+
+// sizeToken parses a token which is a length, a keyword width, or a
+// function of lengths. A var() is a size only if sizeVar.
+func sizeToken(t string, sizeVar bool) (Size, bool) {
+	if s, ok := parseLength(t); ok {
+		return s, true
+	}
+
+	switch l := strings.ToLower(t); {
+	case l == "thin" || l == "medium" || l == "thick",
+		strings.HasPrefix(l, "calc("),
+		strings.HasPrefix(l, "min("),
+		strings.HasPrefix(l, "max("),
+		strings.HasPrefix(l, "clamp("),
+		sizeVar && strings.HasPrefix(l, "var("):
+		return Size{StringOverride: t}, true
+	}
+
+	return Size{}, false
+}
+
+// parseLine parses the width, style and color of a border or an outline,
+// as Val writes them, or in any order.
+func parseLine(v string, style func(string) bool) (width Size, typ, color string, ok bool) {
+	widthSet := false
+	for _, t := range fields(v) {
+		if l := strings.ToLower(t); typ == "" && style(l) {
+			typ = l
+			continue
+		}
+		if !widthSet {
+			if s, ok := sizeToken(t, typ == ""); ok {
+				width, widthSet = s, true
+				continue
+			}
+		}
+		if color != "" {
+			return Size{}, "", "", false
+		}
+		color = t
+	}
+
+	return width, typ, color, true
+}
+
+func parseBorder(v string) (Border, bool) {
+	width, typ, color, ok := parseLine(v, func(s string) bool {
+		_, ok := parseBorderType(s)
+		return ok
+	})
+	if !ok || typ == "" {
+		return Border{}, false
+	}
+	t, _ := parseBorderType(typ)
+
+	return Border{Width: width, Type: t, Color: color}, true
+}
+
+func parseOutline(v string) (Outline, bool) {
+	width, typ, color, ok := parseLine(v, func(s string) bool {
+		_, ok := parseOutlineType(s)
+		return ok
+	})
+	if !ok {
+		return Outline{}, false
+	}
+	t, _ := parseOutlineType(typ)
+
+	return Outline{Width: width, Type: t, Color: color}, true
+}
+
+// parseBoxShadow parses a single shadow: 2 to 4 lengths and a color.
+// A var() is a length unless it is last.
+func parseBoxShadow(v string) (BoxShadow, bool) {
+	var (
+		b       BoxShadow
+		lengths = []*Size{&b.HOffset, &b.VOffset, &b.Blur, &b.Spread}
+		n       int
+	)
+	ts := fields(v)
+	for i, t := range ts {
+		if n < len(lengths) {
+			if s, ok := sizeToken(t, i < len(ts)-1); ok {
+				*lengths[n] = s
+				n++
+				continue
+			}
+		}
+		if i != len(ts)-1 {
+			return BoxShadow{}, false
+		}
+		b.Color = t
+	}
+	if n < 2 {
+		return BoxShadow{}, false
+	}
+
+	return b, true
+}
+
+// }}}
@@ -0,0 +1,91 @@
+package browser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStyle(t *testing.T) {
+	s, unrecognized := ParseStyle(`display:flex; Padding: 4px 8px;;COLOR:Red;float:left;height:red;margin:0 auto;max-width:calc(100% - 4px);` +
+		`background-image:url("data:image/png;base64,AA==");--gap:2px;border:1px solid #ccc;width`)
+
+	want := Style{
+		Display:         DisplayFlex,
+		Padding:         Size{StringOverride: "4px 8px"},
+		Margin:          Size{StringOverride: "0 auto"},
+		MaxWidth:        Size{StringOverride: "calc(100% - 4px)"},
+		Color:           "Red",
+		BackgroundImage: `url("data:image/png;base64,AA==")`,
+		Border:          Border{Width: Size{Value: 1, Unit: UnitPX}, Type: BorderSolid, Color: "#ccc"},
+		Vars:            map[string]string{"--gap": "2px"},
+	}
+	if !s.Equal(&want) {
+		t.Errorf("ParseStyle:\n got %s\nwant %s", s.Val(), want.Val())
+	}
+	if want := []Declaration{{"float", "left"}, {"height", "red"}, {Property: "width"}}; !reflect.DeepEqual(unrecognized, want) {
+		t.Errorf("unrecognized: got %v, want %v", unrecognized, want)
+	}
+}
+
+func TestParseStyleVal(t *testing.T) {
+	n := (&Node{}).
+		AlignSelfCenter().
+		Border(Border{Width: Size{Value: 2, Unit: UnitPX}, Type: BorderDashed, Color: "rgb(0, 0, 0)"}).
+		BoxShadow(BoxShadow{HOffset: Size{Value: 1, Unit: UnitPX}, VOffset: Size{Value: 2, Unit: UnitPX}, Color: "red"}).
+		FlexGrow("1").
+		Outline(Outline{Type: OutlineNone}).
+		PaddingREM(1.5).
+		UserSelect("none").
+		WidthPG(50).
+		SetVar("--accent", "#fff")
+
+	s, unrecognized := ParseStyle(n.Style.Val())
+	if len(unrecognized) > 0 {
+		t.Errorf("unrecognized: %v", unrecognized)
+	}
+	if got, want := s.Val(), n.Style.Val(); got != want {
+		t.Errorf("Val:\n got %s\nwant %s", got, want)
+	}
+
+	// keyframes defined elsewhere are referenced by name, without a rule
+	s, unrecognized = ParseStyle("animation:spin 0.5s ease-in infinite")
+	if len(unrecognized) > 0 || s.Animation.Keyframes.Name() != "spin" || len(s.keyframes()) != 0 {
+		t.Errorf("animation: got %+v, unrecognized %v", s.Animation, unrecognized)
+	}
+	if got, want := s.Val(), "animation:spin 0.5s ease-in infinite;"; got != want {
+		t.Errorf("Val: got %s, want %s", got, want)
+	}
+	for _, v := range []string{"spin 1s 0x10", "1x 1s", "-2x 1s", "none 1s"} {
+		if _, unrecognized := ParseStyle("animation:" + v); len(unrecognized) != 1 {
+			t.Errorf("animation:%s: got it recognized", v)
+		}
+	}
+}
+
+func FuzzParseStyle(f *testing.F) {
+	for _, s := range []string{
+		"display:flex;padding:4px 8px",
+		"border:1px solid red;box-shadow:0 1px 2px rgba(0,0,0,.5)",
+		`font-family:"a;b", serif;--x:var(--y, 1e3px)`,
+		"outline:none;width:calc(100% - 2px);z-index:2",
+		"animation:spin 1s infinite alternate both",
+		"color:'",
+		"a:b)",
+		"AnimAtion:inf 1",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, css string) {
+		s, _ := ParseStyle(css)
+		val := s.Val()
+
+		again, unrecognized := ParseStyle(val)
+		if len(unrecognized) > 0 {
+			t.Fatalf("ParseStyle(%q): Val %q has unrecognized %q", css, val, unrecognized)
+		}
+		if got := again.Val(); got != val {
+			t.Fatalf("ParseStyle(%q): Val %q re-parses to %q", css, val, got)
+		}
+	})
+}
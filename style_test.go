@@ -1,6 +1,9 @@
 package browser
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestExample(t *testing.T) {
 	s := Size{Value: 100, Unit: UnitPX}
@@ -9,3 +12,152 @@ func TestExample(t *testing.T) {
 		t.Fatalf("got %s, want %s", got, want)
 	}
 }
+
+func TestStyleVal(t *testing.T) {
+	n := (&Node{}).
+		DisplayFlex().
+		GapPX(4).
+		ColumnGapREM(1).
+		ZIndex("2").
+		UserSelect("none")
+
+	// gap comes before column-gap, which it would otherwise override
+	want := "display:flex;gap:4.000000px;column-gap:1.000000rem;" +
+		"user-select:none;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;" +
+		"z-index:2;"
+	if got := n.Style.Val(); got != want {
+		t.Errorf("Val:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestStyleVars(t *testing.T) {
+	base := (&Node{}).SetVar("--b", "2").SetVar("--a", "1")
+	n := &Node{Style: base.Style}
+	n.SetVar("--a", "3").Padding(Var("--b").Size())
+
+	if got, want := base.Style.Val(), "--a:1;--b:2;"; got != want {
+		t.Errorf("base Val: got %s, want %s", got, want)
+	}
+	if got, want := n.Style.Val(), "--a:3;--b:2;padding:var(--b);"; got != want {
+		t.Errorf("Val: got %s, want %s", got, want)
+	}
+	if base.Style.Equal(&n.Style) {
+		t.Error("Equal: styles with different vars are equal")
+	}
+}
+
+func TestStyleMerge(t *testing.T) {
+	hover := &Style{Color: "gray", BackgroundColor: "white"}
+	base := Style{
+		Color:       "black",
+		Padding:     PX(4),
+		Display:     DisplayFlex,
+		Vars:        map[string]string{"--a": "1"},
+		Hover:       hover,
+		Breakpoints: []Breakpoint{{MinWidth: PX(600), Style: Style{Padding: PX(8)}}},
+	}
+	s := base
+	s.Merge(Style{
+		Color:       "navy",
+		PaddingLeft: PX(0),
+		Vars:        map[string]string{"--a": "2"},
+		Hover:       &Style{Color: "blue"},
+		Breakpoints: []Breakpoint{{MinWidth: PX(900), Style: Style{Padding: PX(16)}}},
+	})
+
+	// the unset display and padding are kept
+	want := "--a:2;color:navy;display:flex;padding:4.000000px;padding-left:0.000000px;"
+	if got := s.Val(); got != want {
+		t.Errorf("Val:\n got %s\nwant %s", got, want)
+	}
+	if got, want := s.Hover.Val(), "background-color:white;color:blue;"; got != want {
+		t.Errorf("Hover: got %s, want %s", got, want)
+	}
+	if len(s.Breakpoints) != 2 || s.Breakpoints[1].MinWidth != PX(900) {
+		t.Errorf("Breakpoints: got %v, want 600px then 900px", s.Breakpoints)
+	}
+
+	// base, which s shared its maps, variants and slices with, is unchanged
+	if base.Vars["--a"] != "1" || hover.Color != "gray" || base.Hover != hover || len(base.Breakpoints) != 1 {
+		t.Errorf("Merge changed the merged style: %s", base.Val())
+	}
+
+	// merging the zero style changes nothing
+	m := base
+	m.Merge(Style{})
+	if !m.Equal(&base) {
+		t.Errorf("Merge(Style{}): got %s, want %s", m.Val(), base.Val())
+	}
+}
+
+func TestSizeExpressions(t *testing.T) {
+	for _, c := range []struct {
+		size Size
+		want string
+	}{
+		{PG(100).Sub(PX(20)), "calc(100% - 20px)"},
+		{PG(100).Sub(PX(20)).Div(2), "calc((100% - 20px) / 2)"},
+		{Var("--gap").Size().Mul(1.5).Add(EM(1)), "calc((var(--gap) * 1.5) + 1em)"},
+		{MinSize(PX(300), VW(50)), "min(300px, 50vw)"},
+		{ClampSize(REM(1), VW(2.5).Add(PX(4)), REM(2)), "clamp(1rem, (2.5vw + 4px), 2rem)"},
+	} {
+		if got := c.size.String(); got != c.want {
+			t.Errorf("got %s, want %s", got, c.want)
+		}
+	}
+
+	if PX(1).Add(PX(2)) != PX(1).Add(PX(2)) {
+		t.Error("equal expressions are not ==")
+	}
+}
+
+func TestGrid(t *testing.T) {
+	s := Style{
+		Display:             DisplayGrid,
+		GridTemplateColumns: Tracks(PX(200), RepeatFill(MinMax(PX(100), FR(1)))),
+		GridTemplateRows:    Tracks(Repeat(2, FR(1), PG(100).Sub(PX(20)))),
+		GridTemplateAreas:   Areas("a a", "b ."),
+		GridColumn:          Span(2),
+		GridRow:             Lines(1, 3),
+	}
+	want := `display:grid;grid-column:span 2;grid-row:1 / 3;grid-template-areas:"a a" "b .";` +
+		`grid-template-columns:200px repeat(auto-fill, minmax(100px, 1fr));` +
+		`grid-template-rows:repeat(2, 1fr calc(100% - 20px));`
+	if got := s.Val(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	parsed, rest := ParseStyle(want)
+	if len(rest) != 0 {
+		t.Errorf("unrecognized declarations: %v", rest)
+	}
+	if !parsed.Equal(&s) {
+		t.Errorf("parsed %s, want %s", parsed.Val(), want)
+	}
+
+	if got, want := Areas("café menü", `a"b\c`), GridAreas(`"café menü" "a\"b\\c"`); got != want {
+		t.Errorf("Areas: got %s, want %s", got, want)
+	}
+}
+
+func TestTransformsAndTransitions(t *testing.T) {
+	s := Style{
+		Transform: Translate(PG(-50), PG(-50)).Rotate(Deg(45)).Then(Scale(2, 2)),
+		Transition: Transitions(
+			Transition{Property: "opacity", Duration: 200 * time.Millisecond},
+			Transition{Property: "transform", Duration: 300 * time.Millisecond, Easing: CubicBezier(0.4, 0, 0.2, 1), Delay: 50 * time.Millisecond},
+			Transition{Duration: time.Second},
+		),
+	}
+	want := "transform:translate(-50%, -50%) rotate(45deg) scale(2, 2);" +
+		"transition:opacity 0.2s, transform 0.3s cubic-bezier(0.4, 0, 0.2, 1) 0.05s, all 1s;"
+	if got := s.Val(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	transform, transition := "rotate(45deg)", "opacity 0.2s"
+	n := (&Node{}).RawTransform(transform).RawTransition(transition)
+	if got, want := n.Style.Val(), "transform:rotate(45deg);transition:opacity 0.2s;"; got != want {
+		t.Errorf("raw: got %s, want %s", got, want)
+	}
+}
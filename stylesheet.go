@@ -0,0 +1,363 @@
+package browser
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/nlandolfi/browser/dom"
+	"github.com/nlandolfi/browser/internal/rules"
+	"golang.org/x/net/html"
+)
+
+// Stylesheet {{{
+//
+// In the Classes mode, the Mounter writes each distinct Style as the
+// rule of a class, in a stylesheet it manages: a <style> element it adds
+// to the document's head. Its elements get the class, rather than a
+// style attribute.
+//
+// In any mode, inline styles can't express the variants of a Style (e.g., Hover), or
+// its breakpoints, so the Mounter writes them as rules in the stylesheet.
+// Each distinct set of rules gets a class, named by a hash of the rules,
+// which the Mounter adds to the class attribute of the elements with
+// those variants and breakpoints.
+//
+// The declarations are !important, so that they override the element's
+// inline style. A breakpoint's style may have variants, which apply at
+// the breakpoint; other nested variants and breakpoints are ignored.
+//
+// The stylesheet also has the @keyframes rules of the animations in use.
+
+// classPrefix starts the names of the generated classes.
+const classPrefix = "b-"
+
+type variant struct {
+	selector string
+	style    *Style
+}
+
+// A Breakpoint is a style applying when the viewport is at least
+// MinWidth wide.
+type Breakpoint struct {
+	MinWidth Size
+	Style    Style
+}
+
+// Common breakpoints, for phones, tablets, laptops and desktops.
+var (
+	BreakpointSM = PX(640)
+	BreakpointMD = PX(768)
+	BreakpointLG = PX(1024)
+	BreakpointXL = PX(1280)
+)
+
+// Breakpoint adds a style for viewports at least minWidth wide, e.g.,
+//
+//	ui.VStack(nav, main).Breakpoint(browser.BreakpointMD, browser.Style{
+//		FlexDirection: browser.FlexDirectionRow,
+//	})
+//
+// Add breakpoints in increasing order of width, so that the widest
+// applying wins.
+func (n *Node) Breakpoint(minWidth Size, s Style) *Node {
+	// copy, as the slice may be shared with a base style
+	bs := make([]Breakpoint, len(n.Style.Breakpoints), len(n.Style.Breakpoints)+1)
+	copy(bs, n.Style.Breakpoints)
+	n.Style.Breakpoints = append(bs, Breakpoint{MinWidth: minWidth, Style: s})
+	return n
+}
+
+// mergeVariant returns a merged with b, as Style.Merge does.
+func mergeVariant(a, b *Style) *Style {
+	if a == nil || b == nil {
+		if a == nil {
+			return b
+		}
+		return a
+	}
+
+	m := *a
+	m.Merge(*b)
+	return &m
+}
+
+// mergeBreakpoints returns b after a, in a new slice.
+func mergeBreakpoints(a, b []Breakpoint) []Breakpoint {
+	if len(b) == 0 {
+		return a
+	}
+
+	return append(append(make([]Breakpoint, 0, len(a)+len(b)), a...), b...)
+}
+
+func breakpointsEqual(a, b []Breakpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].MinWidth != b[i].MinWidth || !a[i].Style.Equal(&b[i].Style) {
+			return false
+		}
+	}
+	return true
+}
+
+// generatedClass returns the class for the variants and breakpoints of
+// s, and its rules, or "" if s has none.
+func (s *Style) generatedClass() (class string, rules []string) {
+	if len(s.Breakpoints) == 0 && len(s.variants()) == 0 {
+		return "", nil
+	}
+
+	// name the class by the rules for it
+	h := fnv.New64a()
+	for _, r := range s.rules("") {
+		io.WriteString(h, r)
+	}
+	class = fmt.Sprintf("%s%x", classPrefix, h.Sum64())
+
+	rules = s.rules("." + class)
+	if len(rules) == 0 {
+		return "", nil
+	}
+	return class, rules
+}
+
+// rules returns the rules of the variants and breakpoints of s, for the
+// selector, as the Mounter writes them to its stylesheet.
+func (s *Style) rules(selector string) (rules []string) {
+	rules = s.variantRules(selector, "", "")
+	for i := range s.Breakpoints {
+		b := &s.Breakpoints[i]
+		media := "@media (min-width:" + b.MinWidth.String() + "){"
+		if body := important(b.Style.Val()); body != "" {
+			rules = append(rules, media+selector+"{"+body+"}}")
+		}
+		rules = append(rules, b.Style.variantRules(selector, media, "}")...)
+	}
+	return rules
+}
+
+// the browsertest package formats the rules, see internal/rules
+func init() {
+	rules.Of = func(s interface{}, selector string) []string { return s.(*Style).rules(selector) }
+}
+
+// variantRules returns the rules of the variants of s, for the selector,
+// each between the prefix and suffix.
+func (s *Style) variantRules(selector, prefix, suffix string) (rules []string) {
+	for _, v := range s.variants() {
+		if body := important(v.style.Val()); body != "" {
+			rules = append(rules, prefix+selector+v.selector+"{"+body+"}"+suffix)
+		}
+	}
+	return rules
+}
+
+// important marks each declaration of the inline style val !important,
+// see declarations.
+func important(val string) string {
+	return declarations(val, " !important")
+}
+
+// declarations returns the declarations of the inline style val, each
+// followed by the suffix, for the block of a rule. As val may hold any
+// text (e.g., from ParseHTML), it drops those whose value would leave the
+// block, such as "red}body{display:none", see contained.
+func declarations(val, suffix string) string {
+	var b strings.Builder
+	for _, d := range split(val, ';') {
+		d = strings.TrimSpace(d)
+		i := strings.IndexByte(d, ':')
+		if i < 0 || !contained(d[i+1:]) {
+			continue
+		}
+		b.WriteString(d)
+		b.WriteString(suffix + ";")
+	}
+	return b.String()
+}
+
+// attrs returns n's attributes, with its generated classes added to the
+// class attribute.
+func attrs(n *Node) []*html.Attribute {
+	class := n.classes
+	if class == "" {
+		return n.Attr
+	}
+
+	as := make([]*html.Attribute, 0, len(n.Attr)+1)
+	for _, a := range n.Attr {
+		if a.Key == "class" {
+			a = &html.Attribute{Namespace: a.Namespace, Key: a.Key, Val: strings.TrimSpace(a.Val + " " + class)}
+			class = ""
+		}
+		as = append(as, a)
+	}
+	if class != "" {
+		as = append(as, &html.Attribute{Key: "class", Val: class})
+	}
+	return as
+}
+
+// updateStylesheet sets the generated classes of the nodes in the tree
+// rooted at n, and writes the rules for them and for their keyframes.
+//
+// The classes and keyframes are reference counted: a rule is written
+// while a node of the mounted tree uses it, and is dropped once none do.
+// The counts are updated by walking n against the last tree, as
+// reconcileWalker does, so a subtree it skips (the same *Node, in the
+// same position) isn't walked, and only the rules whose classes come
+// into, or go out of, use are inserted into or deleted from the sheet.
+func (m *Mounter) updateStylesheet(n *Node) {
+	if n == m.last {
+		return
+	}
+	if m.rules == nil {
+		m.rules = make(map[string][]string)
+		m.refs = make(map[string]int)
+	}
+
+	var dirty []string // classes which came into, or went out of, use
+	var walk func(old, new *Node)
+	walk = func(old, new *Node) {
+		if old == new {
+			return // nil, or skipped
+		}
+		if old != nil {
+			for _, c := range old.sheetRefs {
+				if m.refs[c]--; m.refs[c] == 0 {
+					delete(m.refs, c)
+					delete(m.rules, c)
+					dirty = append(dirty, c)
+				}
+			}
+		}
+		if new != nil {
+			for i, rs := range m.refsOf(new) {
+				c := new.sheetRefs[i]
+				if m.refs[c]++; m.refs[c] == 1 {
+					m.rules[c] = rs
+					dirty = append(dirty, c)
+				}
+			}
+		}
+
+		var oc, nc []*Node
+		if old != nil {
+			oc = old.Children
+		}
+		if new != nil {
+			nc = new.Children
+		}
+		for i := 0; i < len(oc) || i < len(nc); i++ {
+			var o, c *Node
+			if i < len(oc) {
+				o = oc[i]
+			}
+			if i < len(nc) {
+				c = nc[i]
+			}
+			walk(o, c)
+		}
+	}
+	walk(m.last, n)
+
+	if len(dirty) == 0 {
+		return
+	}
+	if m.sheet == nil {
+		m.sheet = m.Document.CreateElement("style")
+		m.Document.Head().AppendChild(m.sheet)
+	}
+	if s, ok := m.sheet.(dom.StyleSheet); ok {
+		sort.Strings(dirty)
+		for _, c := range dirty {
+			m.updateRules(s, c)
+		}
+		return
+	}
+
+	classes := make([]string, 0, len(m.rules))
+	for c := range m.rules {
+		classes = append(classes, c)
+	}
+	sort.Strings(classes)
+	var b strings.Builder
+	for _, c := range classes {
+		for _, r := range m.rules[c] {
+			b.WriteString(r)
+			b.WriteByte('\n')
+		}
+	}
+	// a value can't close the <style> element
+	text := strings.ReplaceAll(b.String(), "<", `\3c `)
+	m.sheet.SetInnerHTML(template.HTML(text))
+}
+
+// refsOf sets the generated classes of n, and its sheetRefs, and returns
+// the rules of each of the classes and keyframes it uses.
+func (m *Mounter) refsOf(n *Node) (rules [][]string) {
+	n.sheetRefs = nil
+	if n.Type != html.ElementNode {
+		return nil
+	}
+
+	var classes []string
+	if m.Classes {
+		n.classedStyle = true
+		if val := declarations(n.Style.Val(), ""); val != "" {
+			h := fnv.New64a()
+			io.WriteString(h, val)
+			c := fmt.Sprintf("%s%x", classPrefix, h.Sum64())
+			n.sheetRefs = append(n.sheetRefs, c)
+			rules = append(rules, []string{"." + c + "{" + val + "}"})
+			classes = append(classes, c)
+		}
+	}
+	if c, rs := n.Style.generatedClass(); c != "" {
+		n.sheetRefs = append(n.sheetRefs, c)
+		rules = append(rules, rs)
+		classes = append(classes, c)
+	}
+	n.classes = strings.Join(classes, " ")
+	for _, k := range n.Style.keyframes() {
+		n.sheetRefs = append(n.sheetRefs, k.name)
+		rules = append(rules, []string{k.rule})
+	}
+
+	return rules
+}
+
+// updateRules inserts the rules of the class into the sheet, or deletes
+// them, as it is in use or not. The sheet holds the rules of the classes
+// in order, as m.keys records; a rule the sheet rejects is left out.
+func (m *Mounter) updateRules(s dom.StyleSheet, class string) {
+	i := sort.SearchStrings(m.keys, class)
+	j := i
+	for j < len(m.keys) && m.keys[j] == class {
+		j++
+	}
+
+	rs, ok := m.rules[class]
+	switch {
+	case !ok:
+		for k := j - 1; k >= i; k-- {
+			s.DeleteRule(k)
+		}
+		m.keys = append(m.keys[:i], m.keys[j:]...)
+	case i == j:
+		for _, r := range rs {
+			if err := s.InsertRule(r, j); err == nil {
+				m.keys = append(m.keys[:j], append([]string{class}, m.keys[j:]...)...)
+				j++
+			}
+		}
+	}
+}
+
+// }}}
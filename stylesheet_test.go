@@ -0,0 +1,249 @@
+package browser
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nlandolfi/browser/dom/domtest"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestStylesheet(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+
+	view := func(hover string) *Node {
+		n := (&Node{Type: html.ElementNode, DataAtom: atom.Input}).Class("field")
+		if hover != "" {
+			n.HoverStyle(Style{BackgroundColor: hover}).
+				PlaceholderStyle(Style{Color: "gray"})
+		}
+		return n
+	}
+	class := func() string {
+		c, _ := d.BodyElement().Children()[0].Attr("class")
+		return c
+	}
+
+	if err := m.Mount(view("#eee")); err != nil {
+		t.Fatal(err)
+	}
+	cs := strings.Fields(class())
+	if len(cs) != 2 || cs[0] != "field" || !strings.HasPrefix(cs[1], classPrefix) {
+		t.Fatalf("class: got %q, want field and a generated class", class())
+	}
+	want := "." + cs[1] + ":hover{background-color:#eee !important;}\n" +
+		"." + cs[1] + "::placeholder{color:gray !important;}\n"
+	if got := d.HeadElement().HTML(); got != "<head><style>"+want+"</style></head>" {
+		t.Errorf("stylesheet:\n got %s\nwant %s", got, want)
+	}
+
+	if err := m.Mount(view("#ddd")); err != nil {
+		t.Fatal(err)
+	}
+	if c := strings.Fields(class()); len(c) != 2 || c[1] == cs[1] {
+		t.Errorf("class: got %q, want a new generated class", class())
+	}
+
+	if err := m.Mount(view("")); err != nil {
+		t.Fatal(err)
+	}
+	if class() != "field" {
+		t.Errorf("class: got %q, want field", class())
+	}
+	if got := d.HeadElement().HTML(); got != "<head><style></style></head>" {
+		t.Errorf("stylesheet: got %s, want it empty", got)
+	}
+}
+
+func TestBreakpoints(t *testing.T) {
+	s := (&Node{}).
+		Breakpoint(BreakpointMD, Style{FlexDirection: FlexDirectionRow}).
+		Breakpoint(BreakpointLG, Style{Hover: &Style{Color: "red"}}).
+		Style
+
+	class, rules := s.generatedClass()
+	c := "." + class
+	want := []string{
+		"@media (min-width:768.000000px){" + c + "{flex-direction:row !important;}}",
+		"@media (min-width:1024.000000px){" + c + ":hover{color:red !important;}}",
+	}
+	if strings.Join(rules, "\n") != strings.Join(want, "\n") {
+		t.Errorf("rules:\n got %q\nwant %q", rules, want)
+	}
+}
+
+func TestClasses(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body(), Classes: true}
+
+	item := func(s string) *Node {
+		return (&Node{
+			Type:     html.ElementNode,
+			DataAtom: atom.Li,
+			Children: []*Node{{Type: html.TextNode, Data: s}},
+		}).PaddingPX(4).Color("gray")
+	}
+	list := func(items ...string) *Node {
+		n := &Node{Type: html.ElementNode, DataAtom: atom.Ul}
+		for _, s := range items {
+			n.Children = append(n.Children, item(s))
+		}
+		return n
+	}
+
+	if err := m.Mount(list("a", "b", "c")); err != nil {
+		t.Fatal(err)
+	}
+	lis := d.BodyElement().Children()[0].Children()
+	class, _ := lis[0].Attr("class")
+	for _, li := range lis {
+		if c, _ := li.Attr("class"); c != class || !strings.HasPrefix(c, classPrefix) {
+			t.Errorf("class: got %q, want %q", c, class)
+		}
+		if s, ok := li.Attr("style"); ok {
+			t.Errorf("style: got %q, want none", s)
+		}
+	}
+	want := "<head><style>." + class + "{color:gray;padding:4.000000px;}\n</style></head>"
+	if got := d.HeadElement().HTML(); got != want {
+		t.Errorf("stylesheet:\n got %s\nwant %s", got, want)
+	}
+	if m.refs[class] != 3 {
+		t.Errorf("refs: got %d, want 3", m.refs[class])
+	}
+
+	if err := m.Mount(list()); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.HeadElement().HTML(); got != "<head><style></style></head>" {
+		t.Errorf("stylesheet: got %s, want the unused rule collected", got)
+	}
+}
+
+func TestStylesheetUpdates(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+
+	div := func(hover string) *Node {
+		return (&Node{Type: html.ElementNode, DataAtom: atom.Div}).HoverStyle(Style{Color: hover})
+	}
+	parent := func(children ...*Node) *Node {
+		return &Node{Type: html.ElementNode, DataAtom: atom.Div, Children: children}
+	}
+	rule := func(n *Node) string {
+		_, rs := n.Style.generatedClass()
+		return rs[0]
+	}
+	check := func(want ...*Node) {
+		t.Helper()
+		sheet := d.HeadElement().Children()[0]
+		got := strings.Join(sheet.Rules(), "\n")
+		var ws []string
+		for _, n := range want {
+			ws = append(ws, rule(n))
+		}
+		sort.Strings(ws)
+		if w := strings.Join(ws, "\n"); got != w {
+			t.Errorf("rules:\n got %s\nwant %s", got, w)
+		}
+	}
+
+	cached, other := div("red"), div("blue")
+	if err := m.Mount(parent(cached, other)); err != nil {
+		t.Fatal(err)
+	}
+	check(cached, other)
+
+	// the Mounter doesn't walk the skipped subtree, so doesn't see this
+	cached.Style.Hover.Color = "green"
+	if err := m.Mount(parent(cached)); err != nil {
+		t.Fatal(err)
+	}
+	check(div("red"))
+
+	moved := div("gray")
+	if err := m.Mount(parent(moved, cached)); err != nil {
+		t.Fatal(err)
+	}
+	check(moved, cached)
+
+	if err := m.Mount(parent(moved)); err != nil {
+		t.Fatal(err)
+	}
+	check(moved)
+	if len(m.refs) != 1 || len(m.rules) != 1 {
+		t.Errorf("refs %v, rules %v: want only the moved node's", m.refs, m.rules)
+	}
+}
+
+func TestKeyframes(t *testing.T) {
+	d := domtest.NewDocument()
+	m := &Mounter{Document: d, Root: d.Body()}
+
+	spin := NewKeyframes(
+		Keyframe{Offset: 1, Style: Style{Transform: Rotate(Turn(1))}},
+		Keyframe{Offset: 0, Style: Style{Transform: Rotate(Deg(0))}},
+	)
+	view := func(spinning bool) *Node {
+		n := &Node{Type: html.ElementNode, DataAtom: atom.Div}
+		if spinning {
+			n.Animation(Animation{Keyframes: spin, Duration: time.Second, Easing: EaseLinear, Iterations: Infinite})
+		}
+		return n
+	}
+
+	if err := m.Mount(view(true)); err != nil {
+		t.Fatal(err)
+	}
+	name := spin.Name()
+	if s, _ := d.BodyElement().Children()[0].Attr("style"); s != "animation:"+name+" 1s linear infinite;" {
+		t.Errorf("style: got %q", s)
+	}
+	want := "<head><style>@keyframes " + name + "{0%{transform:rotate(0deg);}100%{transform:rotate(1turn);}}\n</style></head>"
+	if got := d.HeadElement().HTML(); got != want {
+		t.Errorf("stylesheet:\n got %s\nwant %s", got, want)
+	}
+
+	if err := m.Mount(view(false)); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.HeadElement().HTML(); got != "<head><style></style></head>" {
+		t.Errorf("stylesheet: got %s, want the unused keyframes collected", got)
+	}
+}
+
+func TestStylesheetInjection(t *testing.T) {
+	bad := []string{
+		"red}body{display:none",
+		`red;}@import url(x);`,
+		"red/*",
+		`"red`,
+	}
+
+	for _, classes := range []bool{false, true} {
+		for _, v := range bad {
+			d := domtest.NewDocument()
+			m := &Mounter{Document: d, Root: d.Body(), Classes: classes}
+			n := (&Node{Type: html.ElementNode, DataAtom: atom.Div}).
+				Color(v).
+				HoverStyle(Style{Color: v, BackgroundColor: "#eee"})
+			if err := m.Mount(n); err != nil {
+				t.Fatal(err)
+			}
+
+			sheet := strings.TrimSuffix(strings.TrimPrefix(d.HeadElement().HTML(), "<head><style>"), "</style></head>")
+			if strings.ContainsAny(sheet, `@/"`) || strings.Contains(sheet, "display") ||
+				!strings.Contains(sheet, "background-color:#eee !important;") {
+				t.Errorf("classes %t, color %q: stylesheet %s, want the color's value dropped", classes, v, sheet)
+			}
+		}
+	}
+
+	if _, unrecognized := ParseStyle("color:red}body{display:none"); len(unrecognized) != 1 {
+		t.Errorf("ParseStyle: got %v unrecognized, want the color", unrecognized)
+	}
+}
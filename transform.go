@@ -0,0 +1,74 @@
+package browser
+
+import "strings"
+
+// Transforms {{{
+//
+// A Transform is a list of transform functions, applied right to left, as
+// CSS applies them. The functions compose by chaining, e.g.,
+//
+//	browser.Translate(browser.PG(-50), browser.PG(-50)).Rotate(browser.Deg(45)).Scale(2, 2)
+//	// translate(-50%, -50%) rotate(45deg) scale(2, 2)
+
+// An Angle is a CSS angle, e.g., "45deg".
+type Angle string
+
+func Deg(v float64) Angle  { return Angle(formatNumber(v) + "deg") }
+func Rad(v float64) Angle  { return Angle(formatNumber(v) + "rad") }
+func Turn(v float64) Angle { return Angle(formatNumber(v) + "turn") }
+
+// A Transform is the value of the transform property.
+type Transform string
+
+func Translate(x, y Size) Transform             { return Transform("").Translate(x, y) }
+func TranslateX(x Size) Transform               { return Transform("").TranslateX(x) }
+func TranslateY(y Size) Transform               { return Transform("").TranslateY(y) }
+func Rotate(a Angle) Transform                  { return Transform("").Rotate(a) }
+func Scale(x, y float64) Transform              { return Transform("").Scale(x, y) }
+func Skew(x, y Angle) Transform                 { return Transform("").Skew(x, y) }
+func Matrix(a, b, c, d, e, f float64) Transform { return Transform("").Matrix(a, b, c, d, e, f) }
+
+func (t Transform) Translate(x, y Size) Transform {
+	return t.Then("translate(" + Transform(x.value()) + ", " + Transform(y.value()) + ")")
+}
+func (t Transform) TranslateX(x Size) Transform {
+	return t.Then("translateX(" + Transform(x.value()) + ")")
+}
+func (t Transform) TranslateY(y Size) Transform {
+	return t.Then("translateY(" + Transform(y.value()) + ")")
+}
+func (t Transform) Rotate(a Angle) Transform { return t.Then("rotate(" + Transform(a) + ")") }
+func (t Transform) Scale(x, y float64) Transform {
+	return t.Then(Transform("scale(" + numbers(x, y) + ")"))
+}
+func (t Transform) Skew(x, y Angle) Transform {
+	return t.Then("skew(" + Transform(x) + ", " + Transform(y) + ")")
+}
+func (t Transform) Matrix(a, b, c, d, e, f float64) Transform {
+	return t.Then(Transform("matrix(" + numbers(a, b, c, d, e, f) + ")"))
+}
+
+// RawTransform sets the transform property to s, e.g., a transform kept
+// as a string; see Transform for the typed builder.
+func (n *Node) RawTransform(s string) *Node { return n.Transform(Transform(s)) }
+
+// Then composes t with u, which applies first.
+func (t Transform) Then(u Transform) Transform {
+	if t == "" {
+		return u
+	}
+	if u == "" {
+		return t
+	}
+	return t + " " + u
+}
+
+func numbers(fs ...float64) string {
+	ns := make([]string, len(fs))
+	for i, f := range fs {
+		ns[i] = formatNumber(f)
+	}
+	return strings.Join(ns, ", ")
+}
+
+// }}}
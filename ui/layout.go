@@ -52,6 +52,14 @@ func VStack(children ...*browser.Node) *browser.Node {
 	}
 }
 
+// Stack is a VStack on viewports narrower than the breakpoint, and an
+// HStack on wider ones, e.g., Stack(browser.BreakpointMD, nav, main).
+func Stack(breakpoint browser.Size, children ...*browser.Node) *browser.Node {
+	return VStack(children...).Breakpoint(breakpoint, browser.Style{
+		FlexDirection: browser.FlexDirectionRow,
+	})
+}
+
 func HSpace(width browser.Size) *browser.Node {
 	return &browser.Node{
 		Type:     html.ElementNode,
@@ -93,6 +101,34 @@ func ZStack(children ...*browser.Node) *browser.Node {
 	}
 }
 
+// Grid lays out its children in the named areas of the rows, each a space
+// separated list of area names, e.g.,
+//
+//	ui.Grid([]string{
+//		"header header",
+//		"nav    main",
+//	},
+//		ui.Area("header", header),
+//		ui.Area("nav", nav),
+//		ui.Area("main", main),
+//	).GridTemplateColumns(browser.Tracks(browser.PX(200), browser.FR(1)))
+func Grid(rows []string, children ...*browser.Node) *browser.Node {
+	return &browser.Node{
+		Type:     html.ElementNode,
+		DataAtom: atom.Div,
+		Style: browser.Style{
+			Display:           browser.DisplayGrid,
+			GridTemplateAreas: browser.Areas(rows...),
+		},
+		Children: children,
+	}
+}
+
+// Area places n in the named area of its Grid.
+func Area(name string, n *browser.Node) *browser.Node {
+	return n.GridArea(name)
+}
+
 // }}}
 
 // Conditionals (e.g. OnlyIf, If) {{{
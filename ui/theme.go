@@ -35,6 +35,38 @@ type Theme struct {
 	HoverBackgroundColor string
 	TextColor            string
 	LinkColor            string
+
+	// Styles, if set, override the styles of the components. It is a
+	// pointer so that a Theme stays comparable.
+	Styles *ThemeStyles
+}
+
+// ThemeStyles are styles merged over those of a Theme's components, see
+// browser.Style.Merge, e.g.,
+//
+//	t := ui.LightTheme
+//	t.Styles = &ui.ThemeStyles{
+//		Button: browser.Style{BorderRadius: browser.PX(4), Hover: &browser.Style{Color: "navy"}},
+//	}
+//
+// To override the style of a single component, use its MergeStyle.
+type ThemeStyles struct {
+	Button  browser.Style
+	Card    browser.Style
+	Heading browser.Style // of H1, H2 and H3
+	Link    browser.Style
+	Text    browser.Style // of Text, Textf and Toggle
+	Input   browser.Style // of the inputs and TextArea
+}
+
+var noStyles ThemeStyles
+
+// styles returns the style overrides of t.
+func (t *Theme) styles() *ThemeStyles {
+	if t.Styles == nil {
+		return &noStyles
+	}
+	return t.Styles
 }
 
 // FirstDiff describes the first difference between the themes.
@@ -66,6 +98,22 @@ func (a *Theme) FirstDiff(b *Theme) string {
 	if a.LinkColor != b.LinkColor {
 		return fmt.Sprintf("LinkColor: %q != %q", a.LinkColor, b.LinkColor)
 	}
+	as, bs := a.styles(), b.styles()
+	for _, s := range []struct {
+		name string
+		a, b *browser.Style
+	}{
+		{"Button", &as.Button, &bs.Button},
+		{"Card", &as.Card, &bs.Card},
+		{"Heading", &as.Heading, &bs.Heading},
+		{"Link", &as.Link, &bs.Link},
+		{"Text", &as.Text, &bs.Text},
+		{"Input", &as.Input, &bs.Input},
+	} {
+		if !s.a.Equal(s.b) {
+			return fmt.Sprintf("Styles.%s: %q != %q", s.name, s.a.Val(), s.b.Val())
+		}
+	}
 	return ""
 }
 
@@ -75,6 +123,45 @@ func (a *Theme) Equal(b *Theme) bool {
 	return a.FirstDiff(b) == ""
 }
 
+// HoverBackground returns the HoverBackgroundColor or, if it is not
+// set, a shade of the BackgroundColor: lighter if the background is
+// dark, darker if it is light.
+func (t *Theme) HoverBackground() string {
+	if t.HoverBackgroundColor != "" {
+		return t.HoverBackgroundColor
+	}
+
+	c, err := browser.ParseColor(t.BackgroundColor)
+	if err != nil {
+		return t.BackgroundColor
+	}
+	if c.Luminance() < 0.18 { // closer in contrast to black than to white
+		return c.Lighten(0.08).String()
+	}
+	return c.Darken(0.08).String()
+}
+
+// The custom properties set by Theme.SetVars.
+const (
+	VarFontFamily           browser.Var = "--font-family"
+	VarBackgroundColor      browser.Var = "--background-color"
+	VarHoverBackgroundColor browser.Var = "--hover-background-color"
+	VarTextColor            browser.Var = "--text-color"
+	VarLinkColor            browser.Var = "--link-color"
+)
+
+// SetVars sets the theme's values as custom properties of n, so that
+// its descendants may be styled with them, e.g., VarTextColor.String().
+// Switching themes then restyles only n.
+func (t *Theme) SetVars(n *browser.Node) *browser.Node {
+	return n.
+		SetVar(string(VarFontFamily), t.FontFamily).
+		SetVar(string(VarBackgroundColor), t.BackgroundColor).
+		SetVar(string(VarHoverBackgroundColor), t.HoverBackground()).
+		SetVar(string(VarTextColor), t.TextColor).
+		SetVar(string(VarLinkColor), t.LinkColor)
+}
+
 var buttonBaseStyle = browser.Style{
 	Padding:   browser.Size{Value: 5, Unit: browser.UnitPX},
 	Cursor:    browser.CursorPointer,
@@ -97,7 +184,9 @@ func (t *Theme) Button(label string) *browser.Node {
 	if t == nil {
 		panic("called Button on nil theme")
 	}
-	return button(label).Color(t.LinkColor)
+	return button(label).Color(t.LinkColor).
+		HoverStyle(browser.Style{BackgroundColor: t.HoverBackground()}).
+		MergeStyle(t.styles().Button)
 }
 
 var cardBaseShadow = browser.BoxShadow{
@@ -142,7 +231,8 @@ func (t *Theme) Card(children ...*browser.Node) *browser.Node {
 	if t == nil {
 		panic("called Card on nil theme")
 	}
-	return card(children...).Background(t.BackgroundColor).Color(t.TextColor).FontFamily(t.FontFamily)
+	return card(children...).Background(t.BackgroundColor).Color(t.TextColor).FontFamily(t.FontFamily).
+		MergeStyle(t.styles().Card)
 }
 
 func h1(children ...*browser.Node) *browser.Node {
@@ -172,7 +262,7 @@ func (t *Theme) H1(children ...*browser.Node) *browser.Node {
 	if t == nil {
 		panic("called H1 on nil theme")
 	}
-	return h1(children...).Color(t.TextColor).FontFamily(t.FontFamily)
+	return h1(children...).Color(t.TextColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Heading)
 }
 
 func (t *Theme) H1f(format string, vs ...interface{}) *browser.Node {
@@ -186,7 +276,7 @@ func (t *Theme) H2(children ...*browser.Node) *browser.Node {
 	if t == nil {
 		panic("called H2 on nil theme")
 	}
-	return h2(children...).Color(t.TextColor).FontFamily(t.FontFamily)
+	return h2(children...).Color(t.TextColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Heading)
 }
 
 func (t *Theme) H2f(format string, vs ...interface{}) *browser.Node {
@@ -200,7 +290,7 @@ func (t *Theme) H3(children ...*browser.Node) *browser.Node {
 	if t == nil {
 		panic("called H3 on nil theme")
 	}
-	return h3(children...).Color(t.TextColor).FontFamily(t.FontFamily)
+	return h3(children...).Color(t.TextColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Heading)
 }
 
 func (t *Theme) H3f(format string, vs ...interface{}) *browser.Node {
@@ -230,7 +320,7 @@ func (t *Theme) Link(href, text string) *browser.Node {
 	if t == nil {
 		panic("called Link on nil theme")
 	}
-	return link(href, text).Color(t.LinkColor).FontFamily(t.FontFamily)
+	return link(href, text).Color(t.LinkColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Link)
 }
 
 var inputBaseStyle = browser.Style{
@@ -295,7 +385,7 @@ func (t *Theme) Text(s string) *browser.Node {
 	if t == nil {
 		panic("called Text on nil theme")
 	}
-	return text(s).Color(t.TextColor).FontFamily(t.FontFamily)
+	return text(s).Color(t.TextColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Text)
 }
 
 func textArea(value *string) *browser.Node {
@@ -321,14 +411,16 @@ func (t *Theme) TextArea(value *string) *browser.Node {
 	if t == nil {
 		panic("called TextArea on nil theme")
 	}
-	return textArea(value).Color(t.TextColor).FontFamily(t.FontFamily).Background(t.BackgroundColor)
+	return textArea(value).Color(t.TextColor).FontFamily(t.FontFamily).Background(t.BackgroundColor).
+		MergeStyle(t.styles().Input)
 }
 
 func (t *Theme) TextInput(value *string) *browser.Node {
 	if t == nil {
 		panic("called TextInput on nil theme")
 	}
-	return textInput(value).Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily)
+	return textInput(value).Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily).
+		MergeStyle(t.styles().Input)
 }
 
 func TextInput(value *string) *browser.Node {
@@ -362,7 +454,7 @@ func (t *Theme) TimeInput(value *time.Time) *browser.Node {
 		*value = time.Date(cur.Year(), cur.Month(), cur.Day(),
 			t.Hour(), t.Minute(),
 			cur.Second(), cur.Nanosecond(), cur.Location())
-	}).Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily)
+	}).Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Input)
 }
 
 func (t *Theme) DateInput(value *time.Time) *browser.Node {
@@ -396,7 +488,7 @@ func (t *Theme) DateInput(value *time.Time) *browser.Node {
 			cur.Second(), cur.Nanosecond(), cur.Location())
 	})
 
-	return n.Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily)
+	return n.Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Input)
 }
 
 func (t *Theme) NumberInput(value *int64) *browser.Node {
@@ -425,7 +517,7 @@ func (t *Theme) NumberInput(value *int64) *browser.Node {
 		*value = i
 	})
 
-	return n.Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily)
+	return n.Color(t.TextColor).Background(t.BackgroundColor).FontFamily(t.FontFamily).MergeStyle(t.styles().Input)
 }
 
 func (t *Theme) Textf(format string, vs ...interface{}) *browser.Node {